@@ -0,0 +1,99 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// Component is a part of App with a start/stop lifecycle.
+// Start should return once the component is started, leaving long-running work in background.
+type Component interface {
+	Start(ctx context.Context) error
+	Stop(ctx context.Context) error
+}
+
+type component struct {
+	start, stop func(context.Context) error
+}
+
+func (c component) Start(ctx context.Context) error { return callIf(c.start, ctx) }
+func (c component) Stop(ctx context.Context) error  { return callIf(c.stop, ctx) }
+
+func callIf(fn func(context.Context) error, ctx context.Context) error {
+	if fn == nil {
+		return nil
+	}
+	return fn(ctx)
+}
+
+// NewComponent returns Component calling start and stop functions; either may be nil.
+func NewComponent(start, stop func(context.Context) error) Component {
+	return component{start, stop}
+}
+
+// App runs registered components until an interrupt signal, then stops them gracefully.
+type App struct {
+	mx          sync.Mutex
+	components  []Component
+	stopTimeout time.Duration
+}
+
+// NewApp returns App giving each component at most stopTimeout to stop (zero means no limit).
+func NewApp(stopTimeout time.Duration) *App {
+	return &App{stopTimeout: stopTimeout}
+}
+
+// Register adds components to the app. Components are stopped in reverse order of registration.
+func (a *App) Register(c ...Component) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+	a.components = append(a.components, c...)
+}
+
+// Run starts all components concurrently, waits until ctx is done or SIGINT/SIGTERM is received,
+// then stops started components in reverse order. If any component fails to start, the others are stopped
+// immediately. Returns joined errors of starting and stopping; panics are recovered as errors.
+func (a *App) Run(ctx context.Context) error {
+	a.mx.Lock()
+	components := append([]Component{}, a.components...)
+	a.mx.Unlock()
+
+	ctx, stop := SignalContext(ctx)
+	defer stop()
+
+	var g Group
+	started := make([]bool, len(components))
+	for i, c := range components {
+		g.Go(func() error {
+			if err := c.Start(ctx); err != nil {
+				return err
+			}
+			started[i] = true
+			return nil
+		})
+	}
+	err := g.Wait()
+	if err == nil {
+		<-ctx.Done()
+	}
+
+	var errs []error
+	for i := len(components) - 1; i >= 0; i-- {
+		if started[i] {
+			errs = append(errs, a.stop(components[i]))
+		}
+	}
+	return errors.Join(append([]error{err}, errs...)...)
+}
+
+func (a *App) stop(c Component) error {
+	if a.stopTimeout <= 0 {
+		return callErr(func() error { return c.Stop(context.Background()) })
+	}
+	_, err := WithTimeoutVal(context.Background(), a.stopTimeout, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, c.Stop(ctx)
+	})
+	return err
+}
@@ -0,0 +1,102 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+)
+
+// AsyncN asynchronously runs several functions, at most limit at a time, and waits for them to complete.
+// Returns joined errors of all panics. Non-positive limit means no limit.
+func AsyncN(limit int, fn ...func()) error {
+	if limit <= 0 || limit > len(fn) {
+		limit = len(fn)
+	}
+	var (
+		wg   sync.WaitGroup
+		errs MultiError
+		sem  = NewSemaphore(limit)
+	)
+	wg.Add(len(fn))
+	for _, f := range fn {
+		_ = sem.Acquire(context.Background())
+		go func(fn func()) {
+			defer wg.Done()
+			defer sem.Release()
+			errs.Add(Call(fn))
+		}(f)
+	}
+	wg.Wait()
+	return errs.ErrorOrNil()
+}
+
+// AsyncCtx asynchronously runs several functions with a shared context and waits for them to complete.
+// The context passed to functions is canceled when ctx is done or when any function fails or panics.
+// Returns joined errors of all functions.
+func AsyncCtx(ctx context.Context, fn ...func(context.Context) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg   sync.WaitGroup
+		errs MultiError
+	)
+	wg.Add(len(fn))
+	for _, f := range fn {
+		go func(fn func(context.Context) error) {
+			defer wg.Done()
+			if err := callErr(func() error { return fn(ctx) }); err != nil {
+				cancel()
+				errs.Add(err)
+			}
+		}(f)
+	}
+	wg.Wait()
+	return errs.ErrorOrNil()
+}
+
+// AsyncVals asynchronously runs several functions and returns their results in input order.
+// Returns joined errors and panics of all functions.
+func AsyncVals[T any](fn ...func() (T, error)) ([]T, error) {
+	var wg sync.WaitGroup
+	res := make([]T, len(fn))
+	errs := make([]error, len(fn))
+	wg.Add(len(fn))
+	for i, f := range fn {
+		go func() {
+			defer wg.Done()
+			errs[i] = callErr(func() (err error) {
+				res[i], err = f()
+				return
+			})
+		}()
+	}
+	wg.Wait()
+	var me MultiError
+	me.Add(errs...) // keep input order
+	return res, me.ErrorOrNil()
+}
+
+// AsyncFirstErr asynchronously runs several functions with a shared context and waits for them to complete.
+// The context is canceled as soon as any function fails or panics; returns that first error.
+func AsyncFirstErr(fn ...func(context.Context) error) error {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var (
+		wg    sync.WaitGroup
+		once  sync.Once
+		first error
+	)
+	wg.Add(len(fn))
+	for _, f := range fn {
+		go func() {
+			defer wg.Done()
+			if err := callErr(func() error { return f(ctx) }); err != nil {
+				once.Do(func() {
+					first = err
+					cancel()
+				})
+			}
+		}()
+	}
+	wg.Wait()
+	return first
+}
@@ -0,0 +1,69 @@
+package xgo
+
+import "sync/atomic"
+
+// Atomic is a typed value that can be loaded and stored atomically. A zero Atomic holds zero value of T.
+type Atomic[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewAtomic returns Atomic holding v.
+func NewAtomic[T any](v T) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(v)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() (v T) {
+	if p := a.p.Load(); p != nil {
+		return *p
+	}
+	return
+}
+
+// Store sets the value to v.
+func (a *Atomic[T]) Store(v T) {
+	a.p.Store(&v)
+}
+
+// Swap sets the value to v and returns the previous value.
+func (a *Atomic[T]) Swap(v T) (old T) {
+	if p := a.p.Swap(&v); p != nil {
+		return *p
+	}
+	return
+}
+
+// Update atomically replaces the value with fn(value) and returns the new value.
+// fn may be called several times under contention.
+func (a *Atomic[T]) Update(fn func(T) T) T {
+	for {
+		p := a.p.Load()
+		var old T
+		if p != nil {
+			old = *p
+		}
+		v := fn(old)
+		if a.p.CompareAndSwap(p, &v) {
+			return v
+		}
+	}
+}
+
+// CompareAndSwap atomically sets the value of a to new if it equals old, and reports whether it did.
+func CompareAndSwap[T comparable](a *Atomic[T], old, new T) bool {
+	for {
+		p := a.p.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		if cur != old {
+			return false
+		}
+		if a.p.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package xgo
+
+import (
+	"context"
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff produces exponentially growing delays. A Backoff is not safe for concurrent use.
+type Backoff struct {
+	Base   time.Duration // first delay
+	Max    time.Duration // cap of delays; non-positive means no cap
+	Factor float64       // growth factor; values <= 1 mean 2
+	Jitter float64       // random jitter added to each delay as a fraction of it
+
+	attempt int
+}
+
+// DelayFor returns the delay before retry number attempt (starting from 0), without jitter.
+func (b *Backoff) DelayFor(attempt int) time.Duration {
+	factor := If(b.Factor > 1, b.Factor, 2)
+	d := float64(b.Base) * math.Pow(factor, float64(attempt))
+	if b.Max > 0 && d > float64(b.Max) {
+		return b.Max
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
+
+// Delay returns the next delay with jitter applied and advances the backoff.
+func (b *Backoff) Delay() time.Duration {
+	d := b.DelayFor(b.attempt)
+	b.attempt++
+	if b.Jitter > 0 {
+		d += time.Duration(rand.Float64() * b.Jitter * float64(d))
+	}
+	return d
+}
+
+// Next sleeps for the next delay; returns ctx.Err() if ctx is done first.
+func (b *Backoff) Next(ctx context.Context) error {
+	return SleepCtx(ctx, b.Delay())
+}
+
+// Reset restarts the backoff from the first delay.
+func (b *Backoff) Reset() {
+	b.attempt = 0
+}
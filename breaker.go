@@ -0,0 +1,91 @@
+package xgo
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned by Breaker.Do when the circuit is open.
+var ErrCircuitOpen = errors.New("xgo: circuit open")
+
+// BreakerState is a state of Breaker.
+type BreakerState int
+
+const (
+	BreakerClosed   BreakerState = iota // calls pass through
+	BreakerOpen                         // calls are rejected
+	BreakerHalfOpen                     // a trial call is allowed
+)
+
+// String returns name of the state.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerClosed:
+		return "closed"
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half-open"
+	}
+	return "unknown"
+}
+
+// Breaker is a circuit breaker: after threshold consecutive failures it rejects calls for cooldown,
+// then lets a trial call through to decide whether to close again.
+type Breaker struct {
+	mx        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     BreakerState
+	failures  int
+	openedAt  time.Time
+	trial     bool // trial call is in flight
+}
+
+// NewBreaker returns a breaker opening after threshold consecutive failures for cooldown.
+func NewBreaker(threshold int, cooldown time.Duration) *Breaker {
+	return &Breaker{threshold: max(threshold, 1), cooldown: cooldown}
+}
+
+// State returns the current state of the breaker.
+func (b *Breaker) State() BreakerState {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.advance()
+	return b.state
+}
+
+// Do calls fn unless the circuit is open. Errors and panics of fn count as failures.
+func (b *Breaker) Do(fn func() error) error {
+	b.mx.Lock()
+	b.advance()
+	switch {
+	case b.state == BreakerOpen, b.state == BreakerHalfOpen && b.trial:
+		b.mx.Unlock()
+		return ErrCircuitOpen
+	case b.state == BreakerHalfOpen:
+		b.trial = true
+	}
+	b.mx.Unlock()
+
+	err := callErr(fn)
+
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.trial = false
+	if err == nil {
+		b.state, b.failures = BreakerClosed, 0
+		return nil
+	}
+	if b.failures++; b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state, b.openedAt = BreakerOpen, time.Now()
+	}
+	return err
+}
+
+func (b *Breaker) advance() {
+	if b.state == BreakerOpen && time.Since(b.openedAt) >= b.cooldown {
+		b.state = BreakerHalfOpen
+	}
+}
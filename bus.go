@@ -0,0 +1,78 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+)
+
+// BusMode is a delivery mode of Bus.
+type BusMode int
+
+const (
+	BusBlock BusMode = iota // Publish waits until each subscriber has room for the value
+	BusDrop                 // Publish drops the value for subscribers with full buffers
+)
+
+// Bus is an in-process typed publish-subscribe bus.
+type Bus[T any] struct {
+	mx     sync.RWMutex
+	subs   map[*busSub[T]]struct{}
+	buffer int
+	mode   BusMode
+}
+
+type busSub[T any] struct {
+	ch  chan T
+	ctx context.Context
+}
+
+// NewBus returns a bus with subscriber channels of given buffer size and delivery mode.
+func NewBus[T any](buffer int, mode BusMode) *Bus[T] {
+	return &Bus[T]{subs: map[*busSub[T]]struct{}{}, buffer: buffer, mode: mode}
+}
+
+// Subscribe returns a channel receiving published values until ctx is done; then the channel is closed.
+func (b *Bus[T]) Subscribe(ctx context.Context) <-chan T {
+	s := &busSub[T]{ch: make(chan T, b.buffer), ctx: ctx}
+	b.mx.Lock()
+	b.subs[s] = struct{}{}
+	b.mx.Unlock()
+	go func() {
+		<-ctx.Done()
+		b.mx.Lock()
+		delete(b.subs, s)
+		b.mx.Unlock()
+		close(s.ch)
+	}()
+	return s.ch
+}
+
+// SubscribeFunc calls fn with each published value in a dedicated goroutine until ctx is done.
+// Panics of fn are recovered per value, so a failing subscriber doesn't affect others.
+func (b *Bus[T]) SubscribeFunc(ctx context.Context, fn func(T)) {
+	ch := b.Subscribe(ctx)
+	go func() {
+		for v := range ch {
+			logPanic(Call(func() { fn(v) }))
+		}
+	}()
+}
+
+// Publish delivers v to all subscribers according to the bus mode.
+func (b *Bus[T]) Publish(v T) {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+	for s := range b.subs {
+		if b.mode == BusDrop {
+			select {
+			case s.ch <- v:
+			default:
+			}
+			continue
+		}
+		select {
+		case s.ch <- v:
+		case <-s.ctx.Done():
+		}
+	}
+}
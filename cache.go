@@ -0,0 +1,115 @@
+package xgo
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Cache is a concurrent-safe LRU cache with optional per-entry TTL.
+type Cache[K comparable, V any] struct {
+	mx      sync.Mutex
+	maxSize int
+	ttl     time.Duration
+	ll      *list.List
+	items   map[K]*list.Element
+	loads   DoOnce[K, V]
+}
+
+type cacheEntry[K comparable, V any] struct {
+	key     K
+	val     V
+	expires time.Time
+}
+
+// NewCache returns a cache holding at most maxSize entries (non-positive means unbounded).
+// Entries expire after ttl unless it is zero.
+func NewCache[K comparable, V any](maxSize int, ttl time.Duration) *Cache[K, V] {
+	return &Cache[K, V]{
+		maxSize: maxSize,
+		ttl:     ttl,
+		ll:      list.New(),
+		items:   map[K]*list.Element{},
+	}
+}
+
+// Get returns the value for key and reports whether it is present and not expired.
+func (c *Cache[K, V]) Get(key K) (v V, ok bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	el, ok := c.items[key]
+	if !ok {
+		return
+	}
+	e := el.Value.(*cacheEntry[K, V])
+	if !e.expires.IsZero() && time.Now().After(e.expires) {
+		c.remove(el)
+		return v, false
+	}
+	c.ll.MoveToFront(el)
+	return e.val, true
+}
+
+// Set stores v for key with the default TTL of the cache.
+func (c *Cache[K, V]) Set(key K, v V) {
+	c.SetTTL(key, v, c.ttl)
+}
+
+// SetTTL stores v for key expiring after ttl (zero means no expiration).
+func (c *Cache[K, V]) SetTTL(key K, v V, ttl time.Duration) {
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if el, ok := c.items[key]; ok {
+		e := el.Value.(*cacheEntry[K, V])
+		e.val, e.expires = v, expires
+		c.ll.MoveToFront(el)
+		return
+	}
+	c.items[key] = c.ll.PushFront(&cacheEntry[K, V]{key: key, val: v, expires: expires})
+	if c.maxSize > 0 && c.ll.Len() > c.maxSize {
+		c.remove(c.ll.Back())
+	}
+}
+
+// Delete removes the value for key.
+func (c *Cache[K, V]) Delete(key K) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if el, ok := c.items[key]; ok {
+		c.remove(el)
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones not yet evicted.
+func (c *Cache[K, V]) Len() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.ll.Len()
+}
+
+// GetOrLoad returns the value for key, loading and storing it by loader if absent.
+// Concurrent loads of the same key share one loader call.
+func (c *Cache[K, V]) GetOrLoad(key K, loader func(K) (V, error)) (V, error) {
+	if v, ok := c.Get(key); ok {
+		return v, nil
+	}
+	return c.loads.Do(key, func() (V, error) {
+		if v, ok := c.Get(key); ok {
+			return v, nil
+		}
+		v, err := loader(key)
+		if err == nil {
+			c.Set(key, v)
+		}
+		return v, err
+	})
+}
+
+func (c *Cache[K, V]) remove(el *list.Element) {
+	c.ll.Remove(el)
+	delete(c.items, el.Value.(*cacheEntry[K, V]).key)
+}
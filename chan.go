@@ -0,0 +1,183 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by RecvCtx when the channel is closed.
+var ErrClosed = errors.New("xgo: channel closed")
+
+// Collect reads all values from ch until it is closed.
+func Collect[T any](ch <-chan T) []T {
+	var res []T
+	for v := range ch {
+		res = append(res, v)
+	}
+	return res
+}
+
+// Drain discards all values from ch until it is closed.
+func Drain[T any](ch <-chan T) {
+	for range ch {
+	}
+}
+
+// SendCtx sends v to ch or returns ctx.Err() if ctx is done first.
+func SendCtx[T any](ctx context.Context, ch chan<- T, v T) error {
+	select {
+	case ch <- v:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RecvCtx receives a value from ch or returns ctx.Err() if ctx is done first, or ErrClosed if ch is closed.
+func RecvCtx[T any](ctx context.Context, ch <-chan T) (v T, err error) {
+	select {
+	case v, ok := <-ch:
+		if !ok {
+			return v, ErrClosed
+		}
+		return v, nil
+	case <-ctx.Done():
+		return v, ctx.Err()
+	}
+}
+
+// CloseSafe closes ch and reports whether it was open; it doesn't panic if ch is already closed.
+func CloseSafe[T any](ch chan T) (ok bool) {
+	defer Mute()
+	close(ch)
+	return true
+}
+
+// SlidingWindow sends the last size values received from ch as a new slice each time a value arrives,
+// starting once size values have been received. The output is closed when ch is closed or ctx is done.
+func SlidingWindow[T any](ctx context.Context, ch <-chan T, size int) <-chan []T {
+	if size <= 0 {
+		panic("xgo: non-positive window size")
+	}
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		window := make([]T, 0, size)
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				if len(window) == size {
+					window = append(window[:0], window[1:]...)
+				}
+				if window = append(window, v); len(window) == size {
+					if SendCtx(ctx, out, append([]T(nil), window...)) != nil {
+						return
+					}
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// BatchChan groups values from ch into batches of at most size values.
+// A non-empty batch is also sent once maxWait elapses since its first value (non-positive maxWait disables it).
+// The output is closed when ch is closed or ctx is done; pending values are flushed in the former case.
+func BatchChan[T any](ctx context.Context, ch <-chan T, size int, maxWait time.Duration) <-chan []T {
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+		var (
+			batch []T
+			timer *time.Timer
+			timeC <-chan time.Time
+		)
+		flush := func() bool {
+			if timer != nil {
+				timer.Stop()
+				timer, timeC = nil, nil
+			}
+			if len(batch) == 0 {
+				return true
+			}
+			err := SendCtx(ctx, out, batch)
+			batch = nil
+			return err == nil
+		}
+		defer flush()
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					return
+				}
+				if batch = append(batch, v); len(batch) == 1 && maxWait > 0 {
+					timer = time.NewTimer(maxWait)
+					timeC = timer.C
+				}
+				if len(batch) >= size && !flush() {
+					return
+				}
+			case <-timeC:
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				batch = nil
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FanIn merges values of all channels into the returned one.
+// The output is closed when all channels are closed or ctx is done.
+func FanIn[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	wg.Add(len(chs))
+	for _, ch := range chs {
+		go func() {
+			defer wg.Done()
+			for {
+				v, err := RecvCtx(ctx, ch)
+				if err != nil || SendCtx(ctx, out, v) != nil {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes values of ch among n returned channels; each value goes to one of them.
+// The outputs are closed when ch is closed or ctx is done.
+func FanOut[T any](ctx context.Context, ch <-chan T, n int) []<-chan T {
+	res := make([]<-chan T, n)
+	for i := range res {
+		out := make(chan T)
+		res[i] = out
+		go func() {
+			defer close(out)
+			for {
+				v, err := RecvCtx(ctx, ch)
+				if err != nil || SendCtx(ctx, out, v) != nil {
+					return
+				}
+			}
+		}()
+	}
+	return res
+}
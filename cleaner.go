@@ -0,0 +1,51 @@
+package xgo
+
+import (
+	"errors"
+	"sync"
+)
+
+// Cleaner is a stack of cleanup functions executed in LIFO order.
+type Cleaner struct {
+	mx  sync.Mutex
+	fns []func() error
+}
+
+// NewCleaner returns an empty Cleaner.
+func NewCleaner() *Cleaner {
+	return &Cleaner{}
+}
+
+// Add pushes cleanup fn.
+func (c *Cleaner) Add(fn func()) {
+	c.AddErr(func() error {
+		fn()
+		return nil
+	})
+}
+
+// AddErr pushes cleanup fn returning an error.
+func (c *Cleaner) AddErr(fn func() error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.fns = append(c.fns, fn)
+}
+
+// Run executes cleanups in reverse order of adding, recovering panics of each,
+// and joins their errors into *err (err may be nil). Cleanups are removed after run.
+func (c *Cleaner) Run(err *error) {
+	c.mx.Lock()
+	fns := c.fns
+	c.fns = nil
+	c.mx.Unlock()
+
+	var errs []error
+	for i := len(fns) - 1; i >= 0; i-- {
+		if e := callErr(fns[i]); e != nil {
+			errs = append(errs, e)
+		}
+	}
+	if err != nil && len(errs) > 0 {
+		*err = errors.Join(append([]error{*err}, errs...)...)
+	}
+}
@@ -0,0 +1,193 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Clock is a source of time. SystemClock is the real one; FakeClock is controlled manually in tests.
+type Clock interface {
+	Now() time.Time
+	Sleep(d time.Duration)
+	After(d time.Duration) <-chan time.Time
+	AfterFunc(d time.Duration, fn func()) Timer
+	NewTicker(d time.Duration) Ticker
+}
+
+// Timer is a timer created by Clock.AfterFunc.
+type Timer interface {
+	Stop() bool
+}
+
+// Ticker is a ticker created by Clock.NewTicker.
+type Ticker interface {
+	C() <-chan time.Time
+	Stop()
+}
+
+// SystemClock is Clock backed by package time.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                             { return time.Now() }
+func (systemClock) Sleep(d time.Duration)                      { time.Sleep(d) }
+func (systemClock) After(d time.Duration) <-chan time.Time     { return time.After(d) }
+func (systemClock) AfterFunc(d time.Duration, fn func()) Timer { return time.AfterFunc(d, fn) }
+func (systemClock) NewTicker(d time.Duration) Ticker           { return systemTicker{time.NewTicker(d)} }
+
+type systemTicker struct {
+	t *time.Ticker
+}
+
+func (t systemTicker) C() <-chan time.Time { return t.t.C }
+func (t systemTicker) Stop()               { t.t.Stop() }
+
+// sleepClock is SleepCtx on clock c.
+func sleepClock(ctx context.Context, c Clock, d time.Duration) error {
+	if c == nil || c == SystemClock {
+		return SleepCtx(ctx, d)
+	}
+	done := make(chan struct{})
+	t := c.AfterFunc(d, func() { close(done) })
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		t.Stop()
+		return ctx.Err()
+	}
+}
+
+// FakeClock is Clock whose time moves only by Advance. It is safe for concurrent use.
+type FakeClock struct {
+	mx     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	c      *FakeClock
+	when   time.Time
+	period time.Duration // non-zero for tickers
+	ch     chan time.Time
+	fn     func()
+}
+
+// NewFakeClock returns a fake clock set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (c *FakeClock) Now() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.now
+}
+
+// Sleep blocks until the clock is advanced by d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// After returns a channel receiving the clock time once it is advanced by d, or at once if d is not positive.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.add(d, 0, nil).ch
+}
+
+// AfterFunc calls fn once the clock is advanced by d. fn runs synchronously within Advance,
+// or at once in its own goroutine if d is not positive.
+func (c *FakeClock) AfterFunc(d time.Duration, fn func()) Timer {
+	return c.add(d, 0, fn)
+}
+
+// NewTicker returns a ticker firing each time the clock is advanced by another d.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("xgo: non-positive ticker interval")
+	}
+	return fakeTicker{c.add(d, d, nil)}
+}
+
+// Waiters returns the number of pending timers, tickers and sleepers.
+func (c *FakeClock) Waiters() int {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return len(c.timers)
+}
+
+// Advance moves the clock forward by d, firing due timers in chronological order.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mx.Lock()
+	target := c.now.Add(d)
+	for {
+		var next *fakeTimer
+		for _, t := range c.timers {
+			if !t.when.After(target) && (next == nil || t.when.Before(next.when)) {
+				next = t
+			}
+		}
+		if next == nil {
+			break
+		}
+		c.now = next.when
+		if next.period > 0 {
+			next.when = next.when.Add(next.period)
+		} else {
+			c.remove(next)
+		}
+		if next.fn != nil {
+			c.mx.Unlock()
+			next.fn()
+			c.mx.Lock()
+			continue
+		}
+		select {
+		case next.ch <- c.now:
+		default:
+		}
+	}
+	c.now = target
+	c.mx.Unlock()
+}
+
+func (c *FakeClock) add(d, period time.Duration, fn func()) *fakeTimer {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	t := &fakeTimer{c: c, when: c.now.Add(d), period: period, ch: make(chan time.Time, 1), fn: fn}
+	if d <= 0 && period == 0 { // already due
+		if fn != nil {
+			go fn()
+		} else {
+			t.ch <- c.now
+		}
+		return t
+	}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+func (c *FakeClock) remove(t *fakeTimer) bool {
+	for i, t2 := range c.timers {
+		if t2 == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.c.mx.Lock()
+	defer t.c.mx.Unlock()
+	return t.c.remove(t)
+}
+
+type fakeTicker struct {
+	t *fakeTimer
+}
+
+func (t fakeTicker) C() <-chan time.Time { return t.t.ch }
+func (t fakeTicker) Stop()               { t.t.Stop() }
@@ -0,0 +1,225 @@
+package xgo
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config is a tree of configuration values addressed by dot-separated paths ("db.port").
+// Later loads override values of earlier ones. Config is safe for concurrent use.
+type Config struct {
+	mx   sync.RWMutex
+	data map[string]any
+}
+
+// NewConfig returns an empty config.
+func NewConfig() *Config {
+	return &Config{data: map[string]any{}}
+}
+
+// LoadJSON merges JSON object data into c.
+func (c *Config) LoadJSON(data []byte) error {
+	var m map[string]any
+	if err := json.Unmarshal(data, &m); err != nil {
+		return fmt.Errorf("xgo: invalid config: %w", err)
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	mergeTree(c.data, m)
+	return nil
+}
+
+// LoadYAML merges YAML mapping data into c. A subset of YAML is supported: block mappings and sequences,
+// flow sequences of scalars, plain and quoted scalars; anchors, tags and block scalars are not.
+func (c *Config) LoadYAML(data []byte) error {
+	m, err := parseYAML(data)
+	if err != nil {
+		return fmt.Errorf("xgo: invalid config: %w", err)
+	}
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	mergeTree(c.data, m)
+	return nil
+}
+
+// LoadFile merges the named JSON (.json) or YAML (.yaml, .yml) config file into c.
+func (c *Config) LoadFile(name string) error {
+	load := c.LoadJSON
+	switch ext := strings.ToLower(filepath.Ext(name)); ext {
+	case ".json":
+	case ".yaml", ".yml":
+		load = c.LoadYAML
+	default:
+		return fmt.Errorf("xgo: unsupported config format %q", ext)
+	}
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return err
+	}
+	return load(data)
+}
+
+// LoadEnv merges environment variables with the given prefix into c:
+// PREFIX_DB_PORT=5432 sets path "db.port" to "5432".
+func (c *Config) LoadEnv(prefix string) {
+	prefix = strings.ToUpper(prefix)
+	if prefix != "" && !strings.HasSuffix(prefix, "_") {
+		prefix += "_"
+	}
+	for _, kv := range os.Environ() {
+		k, v, _ := strings.Cut(kv, "=")
+		if !strings.HasPrefix(k, prefix) || k == prefix {
+			continue
+		}
+		c.Set(strings.ReplaceAll(strings.ToLower(k[len(prefix):]), "_", "."), v)
+	}
+}
+
+// Set sets value at path.
+func (c *Config) Set(path string, v any) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	keys := strings.Split(path, ".")
+	m := c.data
+	for _, k := range keys[:len(keys)-1] {
+		sub, ok := m[k].(map[string]any)
+		if !ok {
+			sub = map[string]any{}
+			m[k] = sub
+		}
+		m = sub
+	}
+	m[keys[len(keys)-1]] = v
+}
+
+// Lookup returns raw value at path and reports whether it is present.
+// Maps and slices are returned as deep copies.
+func (c *Config) Lookup(path string) (any, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	v, ok := c.lookup(path)
+	return copyTree(v), ok
+}
+
+func (c *Config) lookup(path string) (any, bool) {
+	var v any = c.data
+	for _, k := range strings.Split(path, ".") {
+		switch node := v.(type) {
+		case map[string]any:
+			var ok bool
+			if v, ok = node[k]; !ok {
+				return nil, false
+			}
+		case []any:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, false
+			}
+			v = node[i]
+		default:
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// Get returns value at path of c converted to T, or fallback if it is absent or not convertible.
+func Get[T any](c *Config, path string, fallback T) T {
+	v, err := getConfig[T](c, path)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// MustGet returns value at path of c converted to T or panics if it is absent or not convertible.
+func MustGet[T any](c *Config, path string) T {
+	v, err := getConfig[T](c, path)
+	noErr(err)
+	return v
+}
+
+func getConfig[T any](c *Config, path string) (res T, err error) {
+	v, ok := c.Lookup(path)
+	if !ok {
+		return res, fmt.Errorf("xgo: config value %q is not set", path)
+	}
+	if res, ok = v.(T); ok {
+		return
+	}
+	switch p := any(&res).(type) {
+	case *string:
+		*p = ToString(v)
+	case *int:
+		*p, err = configInt[int](v)
+	case *int64:
+		*p, err = configInt[int64](v)
+	case *float64:
+		*p, err = ToFloat(v)
+	case *bool:
+		*p, err = ToBool(v)
+	case *time.Duration:
+		if s, ok := v.(string); ok {
+			*p, err = time.ParseDuration(s)
+		} else {
+			*p, err = configInt[time.Duration](v)
+		}
+	default: // structs, slices, maps
+		var data []byte
+		if data, err = json.Marshal(v); err == nil {
+			err = json.Unmarshal(data, &res)
+		}
+	}
+	if err != nil {
+		err = fmt.Errorf("xgo: invalid config value %q: %w", path, err)
+	}
+	return
+}
+
+// configInt is ToNumber that rejects values with a fractional part instead of truncating them.
+func configInt[T Number](v any) (T, error) {
+	n, err := ToNumber[T](v)
+	if err != nil {
+		return n, err
+	}
+	if f, err := ToFloat(v); err == nil && float64(n) != f {
+		return 0, convErr(v, n)
+	}
+	return n, nil
+}
+
+func copyTree(v any) any {
+	switch node := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(node))
+		for k, v := range node {
+			m[k] = copyTree(v)
+		}
+		return m
+	case []any:
+		s := make([]any, len(node))
+		for i, v := range node {
+			s[i] = copyTree(v)
+		}
+		return s
+	}
+	return v
+}
+
+func mergeTree(dst, src map[string]any) {
+	for k, v := range src {
+		if sm, ok := v.(map[string]any); ok {
+			if dm, ok := dst[k].(map[string]any); ok {
+				mergeTree(dm, sm)
+				continue
+			}
+		}
+		dst[k] = v
+	}
+}
@@ -0,0 +1,175 @@
+package xgo
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ToString returns string representation of v.
+func ToString(v any) string {
+	switch x := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return x
+	case []byte:
+		return string(x)
+	case bool:
+		return strconv.FormatBool(x)
+	case fmt.Stringer:
+		return x.String()
+	case error:
+		return x.Error()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 32)
+	case reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+// ToNumber converts v (number, numeric string or bool) to T.
+// Fractional parts are truncated for integer T; values out of range of T are an error.
+func ToNumber[T Number](v any) (T, error) {
+	switch x := v.(type) {
+	case T:
+		return x, nil
+	case bool:
+		return If[T](x, 1, 0), nil
+	case string:
+		x = strings.TrimSpace(x)
+		if i, err := strconv.ParseInt(x, 10, 64); err == nil {
+			return intTo[T](i, v)
+		}
+		if u, err := strconv.ParseUint(x, 10, 64); err == nil {
+			return uintTo[T](u, v)
+		}
+		f, err := strconv.ParseFloat(x, 64)
+		if err != nil {
+			return 0, convErr(v, T(0))
+		}
+		return floatTo[T](f, v)
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return intTo[T](rv.Int(), v)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return uintTo[T](rv.Uint(), v)
+	case reflect.Float32, reflect.Float64:
+		return floatTo[T](rv.Float(), v)
+	}
+	return 0, convErr(v, T(0))
+}
+
+func isUnsigned[T Number]() bool {
+	var zero, one T = 0, 1
+	return zero-one > zero
+}
+
+func isFloat[T Number]() bool {
+	half := 0.5
+	return T(half) != 0
+}
+
+func intTo[T Number](i int64, v any) (T, error) {
+	if isFloat[T]() {
+		return T(i), nil
+	}
+	if t := T(i); int64(t) == i && (i >= 0 || !isUnsigned[T]()) {
+		return t, nil
+	}
+	return 0, convErr(v, T(0))
+}
+
+func uintTo[T Number](u uint64, v any) (T, error) {
+	if isFloat[T]() {
+		return T(u), nil
+	}
+	if t := T(u); uint64(t) == u && t >= 0 {
+		return t, nil
+	}
+	return 0, convErr(v, T(0))
+}
+
+func floatTo[T Number](f float64, v any) (T, error) {
+	if isFloat[T]() {
+		return T(f), nil
+	}
+	if f = math.Trunc(f); !math.IsNaN(f) && f >= -(1<<63) && f < 1<<64 {
+		if t := T(f); float64(t) == f {
+			return t, nil
+		}
+	}
+	return 0, convErr(v, T(0))
+}
+
+// ToInt converts v (number, numeric string or bool) to int.
+func ToInt(v any) (int, error) {
+	return ToNumber[int](v)
+}
+
+// ToFloat converts v (number, numeric string or bool) to float64.
+func ToFloat(v any) (float64, error) {
+	return ToNumber[float64](v)
+}
+
+// ToBool converts v (bool, number or string accepted by strconv.ParseBool) to bool.
+func ToBool(v any) (bool, error) {
+	switch x := v.(type) {
+	case bool:
+		return x, nil
+	case string:
+		b, err := strconv.ParseBool(strings.TrimSpace(x))
+		if err != nil {
+			return false, convErr(v, false)
+		}
+		return b, nil
+	}
+	if f, err := ToNumber[float64](v); err == nil {
+		return f != 0, nil
+	}
+	return false, convErr(v, false)
+}
+
+// MustToNumber converts v to T or panics.
+func MustToNumber[T Number](v any) T {
+	n, err := ToNumber[T](v)
+	noErr(err)
+	return n
+}
+
+// MustToInt converts v to int or panics.
+func MustToInt(v any) int {
+	n, err := ToInt(v)
+	noErr(err)
+	return n
+}
+
+// MustToFloat converts v to float64 or panics.
+func MustToFloat(v any) float64 {
+	f, err := ToFloat(v)
+	noErr(err)
+	return f
+}
+
+// MustToBool converts v to bool or panics.
+func MustToBool(v any) bool {
+	b, err := ToBool(v)
+	noErr(err)
+	return b
+}
+
+func convErr(v, target any) error {
+	return fmt.Errorf("xgo: cannot convert %#v to %T", v, target)
+}
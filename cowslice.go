@@ -0,0 +1,50 @@
+package xgo
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// CowSlice is a copy-on-write slice: reads are lock-free, writes copy the underlying slice.
+// A zero CowSlice is empty and ready to use.
+type CowSlice[T any] struct {
+	mx sync.Mutex // serializes writers
+	p  atomic.Pointer[[]T]
+}
+
+func (c *CowSlice[T]) load() []T {
+	if p := c.p.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Append appends values to c.
+func (c *CowSlice[T]) Append(values ...T) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	old := c.load()
+	s := make([]T, len(old), len(old)+len(values))
+	copy(s, old)
+	s = append(s, values...)
+	c.p.Store(&s)
+}
+
+// Snapshot returns the current content of c. The result must not be modified.
+func (c *CowSlice[T]) Snapshot() []T {
+	return c.load()
+}
+
+// Range calls fn for each element of the current content of c until fn returns false.
+func (c *CowSlice[T]) Range(fn func(i int, v T) bool) {
+	for i, v := range c.load() {
+		if !fn(i, v) {
+			return
+		}
+	}
+}
+
+// Len returns the number of elements in c.
+func (c *CowSlice[T]) Len() int {
+	return len(c.load())
+}
@@ -0,0 +1,91 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SleepCtx pauses for duration d or until ctx is done; returns ctx.Err() in the latter case.
+func SleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// ErrTimeout is returned by WithTimeout and WithTimeoutVal when fn doesn't complete in time.
+var ErrTimeout = errors.New("xgo: timeout")
+
+// WithTimeout runs fn safely in a new goroutine and waits for it at most duration d.
+// Returns ErrTimeout if fn doesn't complete in time; fn is abandoned and keeps running in background.
+func WithTimeout(d time.Duration, fn func() error) error {
+	_, err := WithTimeoutVal(context.Background(), d, func(context.Context) (struct{}, error) {
+		return struct{}{}, fn()
+	})
+	return err
+}
+
+// WithTimeoutVal runs fn safely with a context limited by timeout d and returns its result.
+// Returns ErrTimeout if fn doesn't complete in time, or ctx.Err() if ctx is done first;
+// fn is abandoned and keeps running in background.
+func WithTimeoutVal[T any](ctx context.Context, d time.Duration, fn func(context.Context) (T, error)) (T, error) {
+	tctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+	v, err := GoVal(func() (T, error) { return fn(tctx) }).Get(tctx)
+	if err != nil && err == tctx.Err() {
+		err = Or(ctx.Err(), ErrTimeout)
+	}
+	return v, err
+}
+
+// After runs fn safely in a new goroutine after duration d unless ctx is done first.
+// The handle reports ctx.Err() if fn was not run.
+func After(ctx context.Context, d time.Duration, fn func()) *Handle {
+	h := &Handle{done: make(chan struct{})}
+	go func() {
+		defer close(h.done)
+		if h.err = SleepCtx(ctx, d); h.err == nil {
+			h.err = Call(fn)
+		}
+	}()
+	return h
+}
+
+// WithFraction returns a copy of ctx whose deadline is the given fraction of the remaining time of ctx.
+// If ctx has no deadline, the copy has none either.
+func WithFraction(ctx context.Context, fraction float64) (context.Context, context.CancelFunc) {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return context.WithCancel(ctx)
+	}
+	now := time.Now()
+	return context.WithDeadline(ctx, now.Add(time.Duration(fraction*float64(deadline.Sub(now)))))
+}
+
+// SplitDeadline divides the remaining time of ctx into parts for sequential calls:
+// part i expires at (i+1)/parts of the remaining time, so time left unused by a part passes to the next.
+// If ctx has no deadline, parts have none either. The cancel function releases all parts.
+func SplitDeadline(ctx context.Context, parts int) ([]context.Context, context.CancelFunc) {
+	res := make([]context.Context, parts)
+	cancels := make([]context.CancelFunc, parts)
+	deadline, ok := ctx.Deadline()
+	now := time.Now()
+	for i := range res {
+		if ok {
+			d := time.Duration(float64(deadline.Sub(now)) * float64(i+1) / float64(parts))
+			res[i], cancels[i] = context.WithDeadline(ctx, now.Add(d))
+		} else {
+			res[i], cancels[i] = context.WithCancel(ctx)
+		}
+	}
+	return res, func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package xgo
+
+// DiffSlices returns elements of next missing in prev (added) and elements of prev missing in next (removed).
+// Duplicates are compared as multisets; order of the results follows the inputs.
+func DiffSlices[T comparable](prev, next []T) (added, removed []T) {
+	count := make(map[T]int, len(prev))
+	for _, v := range prev {
+		count[v]++
+	}
+	for _, v := range next {
+		if count[v] > 0 {
+			count[v]--
+		} else {
+			added = append(added, v)
+		}
+	}
+	for _, v := range prev {
+		if count[v] > 0 {
+			count[v]--
+			removed = append(removed, v)
+		}
+	}
+	return
+}
+
+// DiffMaps returns entries of next with keys missing in prev (added), entries of prev with keys missing in next
+// (removed), and entries of next whose values differ from prev (changed).
+func DiffMaps[K, V comparable](prev, next map[K]V) (added, removed, changed map[K]V) {
+	added, removed, changed = map[K]V{}, map[K]V{}, map[K]V{}
+	for k, v := range next {
+		if pv, ok := prev[k]; !ok {
+			added[k] = v
+		} else if pv != v {
+			changed[k] = v
+		}
+	}
+	for k, v := range prev {
+		if _, ok := next[k]; !ok {
+			removed[k] = v
+		}
+	}
+	return
+}
@@ -0,0 +1,45 @@
+package xgo
+
+import "sync"
+
+// DoOnce deduplicates concurrent calls by key: callers with the same key share one execution.
+// A zero DoOnce is ready to use.
+type DoOnce[K comparable, V any] struct {
+	mx    sync.Mutex
+	calls map[K]*doCall[V]
+}
+
+type doCall[V any] struct {
+	wg  sync.WaitGroup
+	val V
+	err error
+}
+
+// Do runs fn for key unless a call for key is already in flight, in which case it waits for that call.
+// All callers receive the same result; a panic in fn is returned as an error.
+func (d *DoOnce[K, V]) Do(key K, fn func() (V, error)) (V, error) {
+	d.mx.Lock()
+	if c, ok := d.calls[key]; ok {
+		d.mx.Unlock()
+		c.wg.Wait()
+		return c.val, c.err
+	}
+	c := &doCall[V]{}
+	c.wg.Add(1)
+	if d.calls == nil {
+		d.calls = map[K]*doCall[V]{}
+	}
+	d.calls[key] = c
+	d.mx.Unlock()
+
+	c.err = callErr(func() (err error) {
+		c.val, err = fn()
+		return
+	})
+	c.wg.Done()
+
+	d.mx.Lock()
+	delete(d.calls, key)
+	d.mx.Unlock()
+	return c.val, c.err
+}
@@ -0,0 +1,35 @@
+package xgo
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/gob"
+	"encoding/hex"
+)
+
+// MustHexDecode returns bytes of hex string s or panics.
+func MustHexDecode(s string) []byte {
+	data, err := hex.DecodeString(s)
+	noErr(err)
+	return data
+}
+
+// MustBase64Decode returns bytes of standard base64 string s or panics.
+func MustBase64Decode(s string) []byte {
+	data, err := base64.StdEncoding.DecodeString(s)
+	noErr(err)
+	return data
+}
+
+// MustDecodeInto returns value of type T decoded from gob data or panics.
+func MustDecodeInto[T any](data []byte) (v T) {
+	noErr(gob.NewDecoder(bytes.NewReader(data)).Decode(&v))
+	return
+}
+
+// MustBinaryDecode returns fixed-size value of type T read from data in byte order (see binary.Read) or panics.
+func MustBinaryDecode[T any](data []byte, order binary.ByteOrder) (v T) {
+	noErr(binary.Read(bytes.NewReader(data), order, &v))
+	return
+}
@@ -0,0 +1,57 @@
+package xgo
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// EnvValue is a constraint for types supported by Env.
+type EnvValue interface {
+	string | int | int64 | bool | float64 | time.Duration
+}
+
+// Env returns value of environment variable key parsed as T, or fallback if it is unset or invalid.
+func Env[T EnvValue](key string, fallback T) T {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		return fallback
+	}
+	v, err := parseEnv[T](s)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// MustEnv returns value of environment variable key parsed as T or panics if it is unset or invalid.
+func MustEnv[T EnvValue](key string) T {
+	s, ok := os.LookupEnv(key)
+	if !ok {
+		noErr(fmt.Errorf("xgo: environment variable %s is not set", key))
+	}
+	v, err := parseEnv[T](s)
+	if err != nil {
+		noErr(fmt.Errorf("xgo: invalid environment variable %s: %w", key, err))
+	}
+	return v
+}
+
+func parseEnv[T EnvValue](s string) (v T, err error) {
+	switch p := any(&v).(type) {
+	case *string:
+		*p = s
+	case *int:
+		*p, err = strconv.Atoi(s)
+	case *int64:
+		*p, err = strconv.ParseInt(s, 10, 64)
+	case *bool:
+		*p, err = strconv.ParseBool(s)
+	case *float64:
+		*p, err = strconv.ParseFloat(s, 64)
+	case *time.Duration:
+		*p, err = time.ParseDuration(s)
+	}
+	return
+}
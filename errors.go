@@ -0,0 +1,113 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+)
+
+// Wrap returns err annotated with msg and caller file:line, or nil if err is nil.
+func Wrap(err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	return withCaller(fmt.Errorf("%s: %w", msg, err), 1)
+}
+
+// Wrapf returns err annotated with formatted message and caller file:line, or nil if err is nil.
+func Wrapf(err error, format string, args ...any) error {
+	if err == nil {
+		return nil
+	}
+	return withCaller(fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err), 1)
+}
+
+// WithStack returns err annotated with caller file:line, or nil if err is nil.
+func WithStack(err error) error {
+	if err == nil {
+		return nil
+	}
+	return withCaller(err, 1)
+}
+
+// RootCause returns the innermost error of the Unwrap chain of err.
+func RootCause(err error) error {
+	for err != nil {
+		next := errors.Unwrap(err)
+		if next == nil {
+			break
+		}
+		err = next
+	}
+	return err
+}
+
+// IsTimeout reports whether err is a timeout: ErrTimeout, context.DeadlineExceeded or an error with Timeout() true.
+func IsTimeout(err error) bool {
+	var t interface{ Timeout() bool }
+	return errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded) || errors.As(err, &t) && t.Timeout()
+}
+
+// IsTemporary reports whether err reports itself as temporary by Temporary() true.
+func IsTemporary(err error) bool {
+	var t interface{ Temporary() bool }
+	return errors.As(err, &t) && t.Temporary()
+}
+
+// IsCanceled reports whether err is context.Canceled.
+func IsCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+type retryableError struct {
+	error
+}
+
+func (e retryableError) Unwrap() error { return e.error }
+
+// MarkRetryable returns err marked as retryable, or nil if err is nil.
+func MarkRetryable(err error) error {
+	if err == nil {
+		return nil
+	}
+	return retryableError{err}
+}
+
+// IsRetryable reports whether err is marked by MarkRetryable, is a timeout or is temporary.
+// It can be used as predicate of RetryIf.
+func IsRetryable(err error) bool {
+	var r retryableError
+	return errors.As(err, &r) || IsTimeout(err) || IsTemporary(err)
+}
+
+// withCaller annotates err with file:line of the caller skip frames above the caller of withCaller.
+func withCaller(err error, skip int) error {
+	_, file, line, _ := runtime.Caller(skip + 1)
+	return fmt.Errorf("%w\n\t%s:%d", err, file, line)
+}
+
+var errHook atomic.Pointer[func(err error, file string, line int)]
+
+// SetErrHook sets hook called whenever OK, Val, Require and other Must-style helpers convert an error to a panic.
+// Nil hook removes it. Panics of the hook itself are muted.
+func SetErrHook(hook func(err error, file string, line int)) {
+	if hook == nil {
+		errHook.Store(nil)
+		return
+	}
+	errHook.Store(&hook)
+}
+
+// panicErr panics with err annotated by file:line of the caller skip frames above the caller of panicErr.
+func panicErr(err error, skip int) {
+	_, file, line, _ := runtime.Caller(skip + 1)
+	if hook := errHook.Load(); hook != nil {
+		func() {
+			defer Mute()
+			(*hook)(err, file, line)
+		}()
+	}
+	panic(fmt.Errorf("%w\n\t%s:%d", err, file, line))
+}
@@ -0,0 +1,87 @@
+package xgo
+
+import "context"
+
+// Handle is a joinable handle of a goroutine started by GoErr.
+type Handle struct {
+	done chan struct{}
+	err  error
+}
+
+// GoErr runs the function safely in a new goroutine and returns a handle to join it.
+func GoErr(fn func()) *Handle {
+	h := &Handle{done: make(chan struct{})}
+	go func() {
+		defer close(h.done)
+		h.err = Call(fn)
+	}()
+	return h
+}
+
+// Done returns a channel that is closed when the goroutine completes.
+func (h *Handle) Done() <-chan struct{} {
+	return h.done
+}
+
+// Wait waits for the goroutine to complete and returns the recovered panic error, if any.
+func (h *Handle) Wait() error {
+	<-h.done
+	return h.err
+}
+
+// Future is an asynchronously computed value.
+type Future[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// GoVal runs fn safely in a new goroutine and returns a future of its result.
+// A panic in fn is returned as an error from Get.
+func GoVal[T any](fn func() (T, error)) *Future[T] {
+	f := &Future[T]{done: make(chan struct{})}
+	go func() {
+		defer close(f.done)
+		f.err = callErr(func() (err error) {
+			f.val, err = fn()
+			return
+		})
+	}()
+	return f
+}
+
+// Done returns a channel that is closed when the value is computed.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Get waits for the value to be computed and returns it, or returns ctx.Err() if ctx is done first.
+func (f *Future[T]) Get(ctx context.Context) (v T, err error) {
+	select {
+	case <-f.done:
+		return f.val, f.err
+	case <-ctx.Done():
+		return v, ctx.Err()
+	}
+}
+
+// TryGet returns the value if it is already computed; ok reports whether it is.
+func (f *Future[T]) TryGet() (v T, ok bool, err error) {
+	select {
+	case <-f.done:
+		return f.val, true, f.err
+	default:
+		return
+	}
+}
+
+// Then returns a future of fn applied to the value of f. Errors of f are passed through.
+func Then[T, U any](f *Future[T], fn func(T) (U, error)) *Future[U] {
+	return GoVal(func() (u U, err error) {
+		<-f.done
+		if f.err != nil {
+			return u, f.err
+		}
+		return fn(f.val)
+	})
+}
@@ -1,3 +1,3 @@
 module github.com/goldic/xgo
 
-go 1.22.3
+go 1.23
@@ -0,0 +1,42 @@
+package xgo
+
+import "sync"
+
+// Group is a collection of goroutines whose errors and panics are joined on Wait.
+// A zero Group has no concurrency limit.
+type Group struct {
+	wg   sync.WaitGroup
+	sem  chan struct{}
+	errs MultiError
+}
+
+// SetLimit limits the number of active goroutines to n. Non-positive n means no limit.
+// It must not be called while goroutines are active.
+func (g *Group) SetLimit(n int) {
+	if n <= 0 {
+		g.sem = nil
+		return
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs fn safely in a new goroutine. Blocks while the limit of active goroutines is reached.
+func (g *Group) Go(fn func() error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		g.errs.Add(callErr(fn))
+	}()
+}
+
+// Wait waits for all goroutines to complete and returns joined errors of them.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	return g.errs.ErrorOrNil()
+}
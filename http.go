@@ -0,0 +1,35 @@
+package xgo
+
+import (
+	"errors"
+	"log"
+	"net/http"
+)
+
+// RecoverHandler returns a handler that recovers panics of h and passes them to onError.
+// If onError is nil, the panic is logged with its stack and a 500 response is written.
+// http.ErrAbortHandler is re-panicked as net/http expects.
+func RecoverHandler(h http.Handler, onError func(error, http.ResponseWriter, *http.Request)) http.Handler {
+	if onError == nil {
+		onError = logRecovered
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		defer func() {
+			if err != nil {
+				onError(err, w, r)
+			}
+		}()
+		defer CatchIf(&err, func(v any) bool { return v != http.ErrAbortHandler })
+		h.ServeHTTP(w, r)
+	})
+}
+
+func logRecovered(err error, w http.ResponseWriter, r *http.Request) {
+	var stack []byte
+	if pe := (*PanicError)(nil); errors.As(err, &pe) {
+		stack = pe.Stack
+	}
+	log.Printf("xgo: panic serving %s %s: %v\n%s", r.Method, r.URL, err, stack)
+	http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+}
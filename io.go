@@ -0,0 +1,58 @@
+package xgo
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+// MustReadFile returns content of the named file or panics.
+func MustReadFile(name string) []byte {
+	data, err := os.ReadFile(name)
+	noErr(err)
+	return data
+}
+
+// MustWriteFile writes data to the named file or panics.
+func MustWriteFile(name string, data []byte, perm os.FileMode) {
+	noErr(os.WriteFile(name, data, perm))
+}
+
+// CloseQuiet closes c and ignores error. Nil c is allowed.
+func CloseQuiet(c io.Closer) {
+	if c != nil {
+		_ = c.Close()
+	}
+}
+
+// CopyVal copies src to dst and returns number of bytes copied or panics.
+func CopyVal(dst io.Writer, src io.Reader) int64 {
+	n, err := io.Copy(dst, src)
+	noErr(err)
+	return n
+}
+
+// TempFile creates a temporary file by pattern (see os.CreateTemp), passes it to fn, then closes and removes it.
+// Returns joined errors of fn (including recovered panic) and of cleanup.
+func TempFile(pattern string, fn func(*os.File) error) error {
+	f, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return err
+	}
+	err = callErr(func() error { return fn(f) })
+	if e := f.Close(); e != nil && !errors.Is(e, os.ErrClosed) {
+		err = errors.Join(err, e)
+	}
+	return errors.Join(err, os.Remove(f.Name()))
+}
+
+// TempDir creates a temporary directory, passes its path to fn, then removes it with all its content.
+// Returns joined errors of fn (including recovered panic) and of cleanup.
+func TempDir(fn func(dir string) error) error {
+	dir, err := os.MkdirTemp("", "xgo-*")
+	if err != nil {
+		return err
+	}
+	err = callErr(func() error { return fn(dir) })
+	return errors.Join(err, os.RemoveAll(dir))
+}
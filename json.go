@@ -0,0 +1,25 @@
+package xgo
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// MustMarshal returns JSON encoding of v or panics.
+func MustMarshal(v any) []byte {
+	data, err := json.Marshal(v)
+	noErr(err)
+	return data
+}
+
+// MustUnmarshal returns value of type T decoded from JSON data or panics.
+func MustUnmarshal[T any](data []byte) (v T) {
+	noErr(json.Unmarshal(data, &v))
+	return
+}
+
+// Decode returns value of type T decoded from JSON read from r.
+func Decode[T any](r io.Reader) (v T, err error) {
+	err = json.NewDecoder(r).Decode(&v)
+	return
+}
@@ -0,0 +1,34 @@
+package xgo
+
+import "sync"
+
+// KeyedMutex provides per-key locking using a fixed number of striped mutexes:
+// different keys may share a stripe, equal keys always do. Holding several keys at once may deadlock.
+type KeyedMutex[K comparable] struct {
+	hash    func(K) uint64
+	stripes []sync.Mutex
+}
+
+// NewKeyedMutex returns a keyed mutex with the given number of stripes; hash maps keys to stripes.
+func NewKeyedMutex[K comparable](stripes int, hash func(K) uint64) *KeyedMutex[K] {
+	return &KeyedMutex[K]{hash: hash, stripes: make([]sync.Mutex, max(stripes, 1))}
+}
+
+func (m *KeyedMutex[K]) stripe(key K) *sync.Mutex {
+	return &m.stripes[m.hash(key)%uint64(len(m.stripes))]
+}
+
+// Lock locks key.
+func (m *KeyedMutex[K]) Lock(key K) {
+	m.stripe(key).Lock()
+}
+
+// Unlock unlocks key.
+func (m *KeyedMutex[K]) Unlock(key K) {
+	m.stripe(key).Unlock()
+}
+
+// With runs fn with key locked; key is unlocked even if fn panics.
+func (m *KeyedMutex[K]) With(key K, fn func()) {
+	WithLock(m.stripe(key), fn)
+}
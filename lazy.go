@@ -0,0 +1,125 @@
+package xgo
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Lazy returns a function that calls fn once on first access and returns its cached result afterwards.
+// If fn panics, the returned function panics with the same value on every call.
+func Lazy[T any](fn func() T) func() T {
+	return sync.OnceValue(fn)
+}
+
+// Memoize returns a function that caches results of fn by argument.
+// Optional ttl sets how long a cached result stays valid; expired results are evicted at most once per ttl.
+func Memoize[K comparable, V any](fn func(K) V, ttl ...time.Duration) func(K) V {
+	type entry struct {
+		v       V
+		expires time.Time
+	}
+	var (
+		mx        sync.Mutex
+		cache     = map[K]entry{}
+		nextSweep time.Time
+	)
+	return func(k K) V {
+		mx.Lock()
+		e, ok := cache[k]
+		mx.Unlock()
+		if ok && (e.expires.IsZero() || time.Now().Before(e.expires)) {
+			return e.v
+		}
+		e = entry{v: fn(k)}
+		now := time.Now()
+		if len(ttl) > 0 && ttl[0] > 0 {
+			e.expires = now.Add(ttl[0])
+		}
+		mx.Lock()
+		if !e.expires.IsZero() && !now.Before(nextSweep) {
+			for k, e := range cache {
+				if !now.Before(e.expires) {
+					delete(cache, k)
+				}
+			}
+			nextSweep = e.expires
+		}
+		cache[k] = e
+		mx.Unlock()
+		return e.v
+	}
+}
+
+// OnceVal is an alias of Lazy.
+func OnceVal[T any](fn func() T) func() T {
+	return Lazy(fn)
+}
+
+// OnceValErr returns a function that calls fn once and returns its cached result and error afterwards.
+// A panic in fn is cached as an error.
+func OnceValErr[T any](fn func() (T, error)) func() (T, error) {
+	return sync.OnceValues(func() (v T, err error) {
+		defer Catch(&err)
+		return fn()
+	})
+}
+
+// OnceValRetry is like OnceValErr but doesn't cache failures: fn is called again until it succeeds.
+func OnceValRetry[T any](fn func() (T, error)) func() (T, error) {
+	var (
+		mx   sync.Mutex
+		done bool
+		v    T
+	)
+	return func() (T, error) {
+		mx.Lock()
+		defer mx.Unlock()
+		if done {
+			return v, nil
+		}
+		err := callErr(func() (err error) {
+			v, err = fn()
+			return
+		})
+		done = err == nil
+		return v, err
+	}
+}
+
+// OnceRetry is like sync.Once but a call of fn that panics doesn't count as done, so the next Do retries it.
+// A zero OnceRetry is ready to use.
+type OnceRetry struct {
+	Max int // max number of attempts; 0 means unlimited
+
+	mx       sync.Mutex
+	done     atomic.Bool
+	attempts int
+	err      error
+}
+
+// Do calls fn unless a previous call has completed without panic or Max attempts are exhausted.
+// It returns the recovered panic of this call, or of the last attempt once no attempts are left.
+func (o *OnceRetry) Do(fn func()) error {
+	if o.done.Load() {
+		return nil
+	}
+	o.mx.Lock()
+	defer o.mx.Unlock()
+	if o.done.Load() {
+		return nil
+	}
+	if o.Max > 0 && o.attempts >= o.Max {
+		return o.err
+	}
+	o.attempts++
+	if o.err = Call(fn); o.err == nil {
+		o.done.Store(true)
+	}
+	return o.err
+}
+
+// Done reports whether a call of fn has completed without panic.
+func (o *OnceRetry) Done() bool {
+	return o.done.Load()
+}
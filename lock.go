@@ -0,0 +1,31 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TryLockCtx locks mu, retrying TryLock with growing delays until it succeeds or ctx is done.
+func TryLockCtx(ctx context.Context, mu *sync.Mutex) error {
+	for d := time.Microsecond; !mu.TryLock(); d = min(2*d, 10*time.Millisecond) {
+		if err := SleepCtx(ctx, d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WithLock runs fn with mu locked; mu is unlocked even if fn panics.
+func WithLock(mu sync.Locker, fn func()) {
+	mu.Lock()
+	defer mu.Unlock()
+	fn()
+}
+
+// WithRLock runs fn with rw read-locked; rw is unlocked even if fn panics.
+func WithRLock(rw *sync.RWMutex, fn func()) {
+	rw.RLock()
+	defer rw.RUnlock()
+	fn()
+}
@@ -0,0 +1,65 @@
+package xgo
+
+import (
+	"errors"
+	"log"
+	"sync"
+	"sync/atomic"
+)
+
+// Logger receives reports of panics that have no caller to be returned to, e.g. those recovered by Go.
+// *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, args ...any)
+}
+
+type loggerBox struct {
+	l Logger
+}
+
+var logger atomic.Pointer[loggerBox]
+
+func init() {
+	SetLogger(log.Default())
+}
+
+// SetLogger sets logger of orphaned panics; log.Default() is used initially. Nil logger disables reports.
+func SetLogger(l Logger) {
+	logger.Store(&loggerBox{l})
+}
+
+var (
+	logQueue = make(chan error, 64)
+	logOnce  sync.Once
+)
+
+// logPanic reports err to the logger asynchronously if it holds a recovered panic.
+// Reports are dropped if the queue is full.
+func logPanic(err error) {
+	var pe *PanicError
+	if !errors.As(err, &pe) || logger.Load().l == nil {
+		return
+	}
+	logOnce.Do(func() {
+		go func() {
+			for err := range logQueue {
+				writeLog(err)
+			}
+		}()
+	})
+	select {
+	case logQueue <- err:
+	default:
+	}
+}
+
+func writeLog(err error) {
+	defer Mute()
+	l := logger.Load().l
+	if l == nil {
+		return
+	}
+	var pe *PanicError
+	errors.As(err, &pe)
+	l.Printf("xgo: recovered panic: %v\n%s", err, pe.Stack)
+}
@@ -0,0 +1,51 @@
+package xgo
+
+import (
+	"context"
+	"time"
+)
+
+// LoopOption configures Loop.
+type LoopOption func(*loopConfig)
+
+type loopConfig struct {
+	delay   time.Duration
+	onError func(error)
+}
+
+// LoopDelay sets delay between iterations of Loop.
+func LoopDelay(d time.Duration) LoopOption {
+	return func(c *loopConfig) {
+		c.delay = d
+	}
+}
+
+// LoopContinueOnError makes Loop pass errors and panics of fn to onError and continue instead of stopping.
+func LoopContinueOnError(onError func(error)) LoopOption {
+	return func(c *loopConfig) {
+		c.onError = onError
+	}
+}
+
+// Loop calls fn repeatedly until ctx is done, recovering panics per iteration.
+// By default Loop stops and returns the first error or panic of fn; otherwise it returns ctx.Err().
+func Loop(ctx context.Context, fn func(context.Context) error, opts ...LoopOption) error {
+	var c loopConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	for ctx.Err() == nil {
+		if err := callErr(func() error { return fn(ctx) }); err != nil {
+			if c.onError == nil {
+				return err
+			}
+			c.onError(err)
+		}
+		if c.delay > 0 {
+			if err := SleepCtx(ctx, c.delay); err != nil {
+				return err
+			}
+		}
+	}
+	return ctx.Err()
+}
@@ -0,0 +1,26 @@
+package xgo
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// Main runs application entrypoint fn, recovering panics. Cleanups run in reverse order after fn.
+// If fn fails or panics, the error (with the stack for panics) is printed to stderr and the process exits with code 1.
+func Main(fn func() error, cleanups ...func()) {
+	c := NewCleaner()
+	for _, cleanup := range cleanups {
+		c.Add(cleanup)
+	}
+	err := callErr(fn)
+	c.Run(&err)
+	if err == nil {
+		return
+	}
+	fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	if pe := (*PanicError)(nil); errors.As(err, &pe) {
+		fmt.Fprintf(os.Stderr, "\n%s", pe.Stack)
+	}
+	os.Exit(1)
+}
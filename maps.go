@@ -0,0 +1,82 @@
+package xgo
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Keys returns keys of m in unspecified order.
+func Keys[K comparable, V any](m map[K]V) []K {
+	res := make([]K, 0, len(m))
+	for k := range m {
+		res = append(res, k)
+	}
+	return res
+}
+
+// SortedKeys returns keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	res := Keys(m)
+	slices.Sort(res)
+	return res
+}
+
+// Values returns values of m in unspecified order.
+func Values[K comparable, V any](m map[K]V) []V {
+	res := make([]V, 0, len(m))
+	for _, v := range m {
+		res = append(res, v)
+	}
+	return res
+}
+
+// SortedValues returns values of m in ascending order.
+func SortedValues[K comparable, V cmp.Ordered](m map[K]V) []V {
+	res := Values(m)
+	slices.Sort(res)
+	return res
+}
+
+// Invert returns a map with keys and values of m swapped. For duplicate values an arbitrary key wins.
+func Invert[K, V comparable](m map[K]V) map[V]K {
+	res := make(map[V]K, len(m))
+	for k, v := range m {
+		res[v] = k
+	}
+	return res
+}
+
+// MergeMaps returns a new map with entries of all maps.
+// For keys present in several maps, resolve(key, prev, next) picks the value; nil resolve means the last wins.
+func MergeMaps[K comparable, V any](resolve func(key K, prev, next V) V, maps ...map[K]V) map[K]V {
+	res := map[K]V{}
+	for _, m := range maps {
+		for k, v := range m {
+			if prev, ok := res[k]; ok && resolve != nil {
+				v = resolve(k, prev, v)
+			}
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// FilterMap returns a new map with entries of m satisfying filter(k, v).
+func FilterMap[K comparable, V any](m map[K]V, filter func(K, V) bool) map[K]V {
+	res := map[K]V{}
+	for k, v := range m {
+		if filter(k, v) {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// MapToSlice returns fn applied to each entry of m in unspecified order.
+func MapToSlice[K comparable, V, T any](m map[K]V, fn func(K, V) T) []T {
+	res := make([]T, 0, len(m))
+	for k, v := range m {
+		res = append(res, fn(k, v))
+	}
+	return res
+}
@@ -0,0 +1,66 @@
+package xgo
+
+import "cmp"
+
+// Signed is a constraint for signed numeric types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64
+}
+
+// Number is a constraint for numeric types.
+type Number interface {
+	Signed | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Clamp returns v limited to range [lo, hi].
+func Clamp[T cmp.Ordered](v, lo, hi T) T {
+	return min(max(v, lo), hi)
+}
+
+// MinOf returns the smallest of values or zero value if values are empty.
+func MinOf[T cmp.Ordered](values ...T) (v T) {
+	for i, x := range values {
+		if i == 0 || x < v {
+			v = x
+		}
+	}
+	return
+}
+
+// MaxOf returns the largest of values or zero value if values are empty.
+func MaxOf[T cmp.Ordered](values ...T) (v T) {
+	for i, x := range values {
+		if i == 0 || x > v {
+			v = x
+		}
+	}
+	return
+}
+
+// Abs returns the absolute value of v.
+func Abs[T Signed](v T) T {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// Sum returns the sum of s.
+func Sum[T Number](s []T) (sum T) {
+	for _, v := range s {
+		sum += v
+	}
+	return
+}
+
+// Avg returns the arithmetic mean of s or 0 if s is empty.
+func Avg[T Number](s []T) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range s {
+		sum += float64(v)
+	}
+	return sum / float64(len(s))
+}
@@ -0,0 +1,120 @@
+package xgo
+
+import (
+	"math"
+	"math/rand/v2"
+	"sort"
+	"sync/atomic"
+)
+
+const counterShards = 32
+
+// Counter is a monotonic int64 counter with sharded lock-free increments. A zero Counter is ready to use.
+type Counter struct {
+	shards [counterShards]struct {
+		n atomic.Int64
+		_ [56]byte // pad to a cache line
+	}
+}
+
+// Inc adds 1 to the counter.
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+// Add adds n to the counter.
+func (c *Counter) Add(n int64) {
+	c.shards[rand.Uint32()%counterShards].n.Add(n)
+}
+
+// Snapshot returns the current sum of the counter.
+func (c *Counter) Snapshot() (n int64) {
+	for i := range c.shards {
+		n += c.shards[i].n.Load()
+	}
+	return
+}
+
+// Gauge is a float64 value that can be set or adjusted atomically. A zero Gauge is ready to use.
+type Gauge struct {
+	bits atomic.Uint64
+}
+
+// Set sets the gauge to v.
+func (g *Gauge) Set(v float64) {
+	g.bits.Store(math.Float64bits(v))
+}
+
+// Add adds delta to the gauge.
+func (g *Gauge) Add(delta float64) {
+	addFloat(&g.bits, delta)
+}
+
+// Snapshot returns the current value of the gauge.
+func (g *Gauge) Snapshot() float64 {
+	return math.Float64frombits(g.bits.Load())
+}
+
+// Histogram counts observations into buckets with fixed upper bounds.
+type Histogram struct {
+	bounds []float64
+	counts []atomic.Uint64 // len(bounds)+1, the last bucket is +Inf
+	count  Counter
+	sum    atomic.Uint64
+}
+
+// HistogramSnapshot is a point-in-time copy of a Histogram.
+type HistogramSnapshot struct {
+	Bounds []float64 // upper bounds of buckets
+	Counts []uint64  // observations per bucket; the last one counts values above all bounds
+	Count  int64     // total number of observations
+	Sum    float64   // sum of all observed values
+}
+
+// NewHistogram returns a histogram with the given bucket upper bounds.
+func NewHistogram(bounds ...float64) *Histogram {
+	bounds = append([]float64(nil), bounds...)
+	sort.Float64s(bounds)
+	return &Histogram{
+		bounds: bounds,
+		counts: make([]atomic.Uint64, len(bounds)+1),
+	}
+}
+
+// Observe records v.
+func (h *Histogram) Observe(v float64) {
+	h.counts[sort.SearchFloat64s(h.bounds, v)].Add(1)
+	h.count.Inc()
+	addFloat(&h.sum, v)
+}
+
+// Snapshot returns a copy of the current histogram state.
+func (h *Histogram) Snapshot() HistogramSnapshot {
+	s := HistogramSnapshot{
+		Bounds: append([]float64(nil), h.bounds...),
+		Counts: make([]uint64, len(h.counts)),
+		Count:  h.count.Snapshot(),
+		Sum:    math.Float64frombits(h.sum.Load()),
+	}
+	for i := range h.counts {
+		s.Counts[i] = h.counts[i].Load()
+	}
+	return s
+}
+
+// Mean returns the average observed value or 0 if there are no observations.
+func (s HistogramSnapshot) Mean() float64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.Sum / float64(s.Count)
+}
+
+func addFloat(bits *atomic.Uint64, delta float64) {
+	for {
+		old := bits.Load()
+		if bits.CompareAndSwap(old, math.Float64bits(math.Float64frombits(old)+delta)) {
+			return
+		}
+	}
+}
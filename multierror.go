@@ -0,0 +1,90 @@
+package xgo
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// MultiError collects errors; it is safe for concurrent use. A zero MultiError has no message limit.
+type MultiError struct {
+	Limit int // max number of messages shown by Error; non-positive means no limit
+
+	mx   sync.Mutex
+	errs []error
+}
+
+// NewMultiError returns a collector showing at most limit messages in Error.
+func NewMultiError(limit int) *MultiError {
+	return &MultiError{Limit: limit}
+}
+
+// Add appends non-nil errors to m.
+func (m *MultiError) Add(errs ...error) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	for _, err := range errs {
+		if err != nil {
+			m.errs = append(m.errs, err)
+		}
+	}
+}
+
+// Len returns the number of collected errors.
+func (m *MultiError) Len() int {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return len(m.errs)
+}
+
+// Errors returns collected errors.
+func (m *MultiError) Errors() []error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return append([]error{}, m.errs...)
+}
+
+// Error returns messages of collected errors on separate lines, summarizing those beyond Limit.
+func (m *MultiError) Error() string {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	n := len(m.errs)
+	if m.Limit > 0 && n > m.Limit {
+		n = m.Limit
+	}
+	msgs := make([]string, n, n+1)
+	for i, err := range m.errs[:n] {
+		msgs[i] = err.Error()
+	}
+	if more := len(m.errs) - n; more > 0 {
+		msgs = append(msgs, fmt.Sprintf("and %d more", more))
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns collected errors for errors.Is and errors.As.
+func (m *MultiError) Unwrap() []error {
+	return m.Errors()
+}
+
+// ErrorOrNil returns nil if no errors were collected, otherwise a snapshot of m.
+func (m *MultiError) ErrorOrNil() error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	if len(m.errs) == 0 {
+		return nil
+	}
+	return &MultiError{Limit: m.Limit, errs: append([]error{}, m.errs...)}
+}
+
+// Reset returns the same as ErrorOrNil and clears collected errors in one step.
+func (m *MultiError) Reset() error {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	if len(m.errs) == 0 {
+		return nil
+	}
+	err := &MultiError{Limit: m.Limit, errs: m.errs}
+	m.errs = nil
+	return err
+}
@@ -0,0 +1,59 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WaitUntil polls cond every interval until it returns true or ctx is done; returns ctx.Err() in the latter case.
+func WaitUntil(ctx context.Context, interval time.Duration, cond func() bool) error {
+	for !cond() {
+		if err := SleepCtx(ctx, interval); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Notify is a broadcast primitive: Broadcast wakes all current waiters passing them a value.
+// A zero Notify is ready to use.
+type Notify[T any] struct {
+	mx  sync.Mutex
+	cur *notifyGen[T]
+}
+
+type notifyGen[T any] struct {
+	ch  chan struct{}
+	val T
+}
+
+func (n *Notify[T]) gen() *notifyGen[T] {
+	if n.cur == nil {
+		n.cur = &notifyGen[T]{ch: make(chan struct{})}
+	}
+	return n.cur
+}
+
+// Wait blocks until the next Broadcast and returns its value, or returns ctx.Err() if ctx is done first.
+func (n *Notify[T]) Wait(ctx context.Context) (v T, err error) {
+	n.mx.Lock()
+	g := n.gen()
+	n.mx.Unlock()
+	select {
+	case <-g.ch:
+		return g.val, nil
+	case <-ctx.Done():
+		return v, ctx.Err()
+	}
+}
+
+// Broadcast wakes all goroutines waiting in Wait, passing them v.
+func (n *Notify[T]) Broadcast(v T) {
+	n.mx.Lock()
+	g := n.gen()
+	n.cur = nil
+	n.mx.Unlock()
+	g.val = v
+	close(g.ch)
+}
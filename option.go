@@ -0,0 +1,73 @@
+package xgo
+
+// Option holds either a value or nothing.
+type Option[T any] struct {
+	val T
+	ok  bool
+}
+
+// Some returns Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{val: v, ok: true}
+}
+
+// None returns empty Option.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// FromPtr returns Option holding *p, or None if p is nil.
+func FromPtr[T any](p *T) Option[T] {
+	if p == nil {
+		return None[T]()
+	}
+	return Some(*p)
+}
+
+// IsSome reports whether o holds a value.
+func (o Option[T]) IsSome() bool {
+	return o.ok
+}
+
+// IsNone reports whether o is empty.
+func (o Option[T]) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns value of o and reports whether it is present.
+func (o Option[T]) Get() (T, bool) {
+	return o.val, o.ok
+}
+
+// GetOr returns value of o or fallback if o is empty.
+func (o Option[T]) GetOr(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.val
+}
+
+// Filter returns o if it holds a value satisfying filter(v), otherwise None.
+func (o Option[T]) Filter(filter func(T) bool) Option[T] {
+	if o.ok && filter(o.val) {
+		return o
+	}
+	return None[T]()
+}
+
+// Ptr returns pointer to a copy of value of o, or nil if o is empty.
+func (o Option[T]) Ptr() *T {
+	if !o.ok {
+		return nil
+	}
+	v := o.val
+	return &v
+}
+
+// MapOption returns Option of fn(v) if o holds a value, otherwise None.
+func MapOption[T, U any](o Option[T], fn func(T) U) Option[U] {
+	if !o.ok {
+		return None[U]()
+	}
+	return Some(fn(o.val))
+}
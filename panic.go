@@ -0,0 +1,123 @@
+package xgo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync/atomic"
+)
+
+// PanicError is an error that holds a recovered panic value and the stack of the panicking goroutine.
+type PanicError struct {
+	Value     any    // recovered value
+	Stack     []byte // stack trace of the panicking goroutine
+	Goroutine int64  // ID of the panicking goroutine
+
+	reported bool
+}
+
+var panicHook atomic.Pointer[func(recovered any, stack []byte)]
+
+// SetPanicHook sets hook called on every panic recovered by Catch, Call, Go, Async and other helpers
+// built on them. Nil hook removes it. Panics of the hook itself are muted.
+func SetPanicHook(hook func(recovered any, stack []byte)) {
+	if hook == nil {
+		panicHook.Store(nil)
+		return
+	}
+	panicHook.Store(&hook)
+}
+
+// report passes e to the panic hook once.
+func (e *PanicError) report() {
+	if hook := panicHook.Load(); hook != nil && !e.reported {
+		e.reported = true
+		defer Mute()
+		(*hook)(e.Value, e.Stack)
+	}
+}
+
+func newPanicError(r any) *PanicError {
+	if e, ok := r.(*PanicError); ok {
+		return e
+	}
+	stack := make([]byte, 64<<10)
+	stack = stack[:runtime.Stack(stack, false)]
+	return &PanicError{
+		Value:     r,
+		Stack:     stack,
+		Goroutine: goroutineID(stack),
+	}
+}
+
+// Error returns the message of the recovered value.
+func (e *PanicError) Error() string {
+	if err, ok := e.Value.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprint(e.Value)
+}
+
+// Unwrap returns the recovered value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// CatchIf recovers and returns error by argument pointer if pred(recovered) is true, otherwise re-panics.
+func CatchIf(err *error, pred func(any) bool) {
+	if r := recover(); r != nil {
+		if !pred(r) {
+			panic(r)
+		}
+		catch(err, r)
+	}
+}
+
+// CatchOnly recovers and returns error by argument pointer if the panic is an error of type E, otherwise re-panics.
+func CatchOnly[E error](err *error) {
+	if r := recover(); r != nil {
+		var target E
+		if e, ok := r.(error); !ok || !errors.As(e, &target) {
+			panic(r)
+		}
+		catch(err, r)
+	}
+}
+
+// CatchAnd recovers and returns error by argument pointer like Catch, and calls onPanic with the recovered value.
+func CatchAnd(err *error, onPanic func(any)) {
+	if r := recover(); r != nil {
+		catch(err, r)
+		onPanic(r)
+	}
+}
+
+// Rethrow calls onPanic with the recovered value and panics again with it.
+// Use it as "defer Rethrow(fn)" to record panics that must still crash.
+func Rethrow(onPanic func(any)) {
+	if r := recover(); r != nil {
+		onPanic(r)
+		panic(r)
+	}
+}
+
+// goroutineID parses goroutine ID from the header of runtime.Stack output ("goroutine 1 [running]:").
+func goroutineID(stack []byte) int64 {
+	stack = bytes.TrimPrefix(stack, []byte("goroutine "))
+	if i := bytes.IndexByte(stack, ' '); i > 0 {
+		id, _ := strconv.ParseInt(string(stack[:i]), 10, 64)
+		return id
+	}
+	return 0
+}
+
+// toError converts any value to an error.
+func toError(v any) error {
+	if err, ok := v.(error); ok {
+		return err
+	}
+	return fmt.Errorf("%v", v)
+}
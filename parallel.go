@@ -0,0 +1,101 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ParallelMap applies fn to items using at most workers goroutines and returns results in input order.
+// Panics are recovered per item; errors of all items are joined.
+func ParallelMap[T, U any](items []T, workers int, fn func(T) (U, error)) ([]U, error) {
+	res := make([]U, len(items))
+	var g Group
+	g.SetLimit(workers)
+	for i, v := range items {
+		g.Go(func() (err error) {
+			res[i], err = fn(v)
+			return
+		})
+	}
+	return res, g.Wait()
+}
+
+// ForEachOption configures ForEachParallel.
+type ForEachOption func(*forEachConfig)
+
+type forEachConfig struct {
+	collectAll bool
+}
+
+// CollectAll makes ForEachParallel process all items regardless of errors instead of failing fast.
+func CollectAll() ForEachOption {
+	return func(c *forEachConfig) {
+		c.collectAll = true
+	}
+}
+
+// ForEachParallel calls fn for each item using at most workers goroutines, recovering panics per item.
+// By default it stops scheduling new items and cancels the context passed to fn on the first error.
+// Returns joined errors of fn, joined with ctx.Err() if ctx is done.
+func ForEachParallel[T any](ctx context.Context, items []T, workers int, fn func(context.Context, T) error, opts ...ForEachOption) error {
+	var c forEachConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	fctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var g Group
+	g.SetLimit(workers)
+	for _, v := range items {
+		if fctx.Err() != nil {
+			break
+		}
+		g.Go(func() error {
+			if fctx.Err() != nil { // failed or canceled while waiting for a slot
+				return nil
+			}
+			err := callErr(func() error { return fn(fctx, v) })
+			if err != nil && !c.collectAll {
+				cancel()
+			}
+			return err
+		})
+	}
+	return errors.Join(g.Wait(), ctx.Err())
+}
+
+// RunWithProgress calls fn for each item using at most workers goroutines, recovering panics per item,
+// and calls onProgress (serialized) after each completed item. Returns joined errors of all items.
+func RunWithProgress[T any](items []T, workers int, fn func(T) error, onProgress func(done, total int)) error {
+	var (
+		g    Group
+		mx   sync.Mutex
+		done int
+	)
+	g.SetLimit(workers)
+	for _, v := range items {
+		g.Go(func() error {
+			defer func() {
+				mx.Lock()
+				defer mx.Unlock()
+				done++
+				if onProgress != nil {
+					onProgress(done, len(items))
+				}
+			}()
+			return fn(v)
+		})
+	}
+	return g.Wait()
+}
+
+// ETA estimates remaining time of a job started at start with done of total items completed.
+func ETA(start time.Time, done, total int) time.Duration {
+	if done <= 0 || done >= total {
+		return 0
+	}
+	elapsed := time.Since(start)
+	return time.Duration(float64(elapsed) / float64(done) * float64(total-done))
+}
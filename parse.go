@@ -0,0 +1,57 @@
+package xgo
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MustAtoi returns s converted to int or panics.
+func MustAtoi(s string) int {
+	v, err := strconv.Atoi(s)
+	noErr(parseErr("int", s, err))
+	return v
+}
+
+// MustParseFloat returns s converted to float64 or panics.
+func MustParseFloat(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	noErr(parseErr("float", s, err))
+	return v
+}
+
+// MustParseBool returns s converted to bool or panics.
+func MustParseBool(s string) bool {
+	v, err := strconv.ParseBool(s)
+	noErr(parseErr("bool", s, err))
+	return v
+}
+
+// MustParseURL returns parsed URL or panics.
+func MustParseURL(s string) *url.URL {
+	v, err := url.Parse(s)
+	noErr(parseErr("url", s, err))
+	return v
+}
+
+// MustParseTime returns time parsed by layout or panics.
+func MustParseTime(layout, s string) time.Time {
+	v, err := time.Parse(layout, s)
+	noErr(parseErr("time", s, err))
+	return v
+}
+
+// MustParseDuration returns parsed duration or panics.
+func MustParseDuration(s string) time.Duration {
+	v, err := time.ParseDuration(s)
+	noErr(parseErr("duration", s, err))
+	return v
+}
+
+func parseErr(kind, s string, err error) error {
+	if err != nil {
+		return fmt.Errorf("xgo: invalid %s %q: %w", kind, s, err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package xgo
+
+import "sync"
+
+// Pool is a bounded executor running at most size tasks at a time.
+type Pool struct {
+	sem    chan struct{}
+	mx     sync.Mutex
+	idle   sync.Cond // signaled when active drops to zero
+	active int       // number of running tasks
+	errs   MultiError
+}
+
+// NewPool returns a pool running at most size tasks concurrently.
+func NewPool(size int) *Pool {
+	if size <= 0 {
+		size = 1
+	}
+	p := &Pool{sem: make(chan struct{}, size)}
+	p.idle.L = &p.mx
+	return p
+}
+
+// Submit runs fn in the pool. Blocks if all workers are busy.
+func (p *Pool) Submit(fn func()) {
+	p.SubmitErr(func() error {
+		fn()
+		return nil
+	})
+}
+
+// SubmitErr runs fn in the pool, recording its error or panic. Blocks if all workers are busy.
+func (p *Pool) SubmitErr(fn func() error) {
+	p.sem <- struct{}{}
+	p.mx.Lock()
+	p.active++
+	p.mx.Unlock()
+	go func() {
+		defer p.done()
+		p.errs.Add(callErr(fn))
+	}()
+}
+
+// Wait waits for all submitted tasks to complete and returns collected errors, clearing them.
+// Errors of tasks submitted concurrently with Wait are returned by the next Wait.
+func (p *Pool) Wait() error {
+	p.mx.Lock()
+	for p.active > 0 {
+		p.idle.Wait()
+	}
+	p.mx.Unlock()
+	return p.errs.Reset()
+}
+
+func (p *Pool) done() {
+	<-p.sem
+	p.mx.Lock()
+	if p.active--; p.active == 0 {
+		p.idle.Broadcast()
+	}
+	p.mx.Unlock()
+}
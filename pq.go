@@ -0,0 +1,134 @@
+package xgo
+
+import (
+	"container/heap"
+	"context"
+	"sync"
+)
+
+// PQ is a priority queue ordered by less: Pop returns the least value. PQ is not safe for concurrent use.
+type PQ[T any] struct {
+	h pqHeap[T]
+}
+
+type pqHeap[T any] struct {
+	items []T
+	less  func(a, b T) bool
+}
+
+func (h *pqHeap[T]) Len() int           { return len(h.items) }
+func (h *pqHeap[T]) Less(i, j int) bool { return h.less(h.items[i], h.items[j]) }
+func (h *pqHeap[T]) Swap(i, j int)      { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *pqHeap[T]) Push(v any) {
+	x, _ := v.(T) // nil interface value
+	h.items = append(h.items, x)
+}
+func (h *pqHeap[T]) Pop() any {
+	var v0 T
+	n := len(h.items) - 1
+	v := h.items[n]
+	h.items[n] = v0
+	h.items = h.items[:n]
+	return v
+}
+
+// NewPQ returns an empty priority queue ordered by less.
+func NewPQ[T any](less func(a, b T) bool) *PQ[T] {
+	return &PQ[T]{h: pqHeap[T]{less: less}}
+}
+
+// Len returns the number of values in q.
+func (q *PQ[T]) Len() int {
+	return len(q.h.items)
+}
+
+// Push adds v to q.
+func (q *PQ[T]) Push(v T) {
+	heap.Push(&q.h, v)
+}
+
+// Pop removes and returns the least value of q; ok is false if q is empty.
+func (q *PQ[T]) Pop() (v T, ok bool) {
+	if len(q.h.items) == 0 {
+		return
+	}
+	v, _ = heap.Pop(&q.h).(T)
+	return v, true
+}
+
+// Peek returns the least value of q without removing it; ok is false if q is empty.
+func (q *PQ[T]) Peek() (v T, ok bool) {
+	if len(q.h.items) == 0 {
+		return
+	}
+	return q.h.items[0], true
+}
+
+// SyncPQ is a priority queue safe for concurrent use.
+type SyncPQ[T any] struct {
+	mx     sync.Mutex
+	q      *PQ[T]
+	notify chan struct{}
+}
+
+// NewSyncPQ returns an empty concurrent-safe priority queue ordered by less.
+func NewSyncPQ[T any](less func(a, b T) bool) *SyncPQ[T] {
+	return &SyncPQ[T]{q: NewPQ(less), notify: make(chan struct{}, 1)}
+}
+
+// Len returns the number of values in q.
+func (q *SyncPQ[T]) Len() int {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+	return q.q.Len()
+}
+
+// Push adds v to q.
+func (q *SyncPQ[T]) Push(v T) {
+	q.mx.Lock()
+	q.q.Push(v)
+	q.mx.Unlock()
+	q.signal()
+}
+
+// Pop removes and returns the least value of q; ok is false if q is empty.
+func (q *SyncPQ[T]) Pop() (v T, ok bool) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+	return q.q.Pop()
+}
+
+// Peek returns the least value of q without removing it; ok is false if q is empty.
+func (q *SyncPQ[T]) Peek() (v T, ok bool) {
+	q.mx.Lock()
+	defer q.mx.Unlock()
+	return q.q.Peek()
+}
+
+// PopCtx removes and returns the least value of q, blocking while q is empty or until ctx is done.
+func (q *SyncPQ[T]) PopCtx(ctx context.Context) (v T, err error) {
+	for {
+		q.mx.Lock()
+		v, ok := q.q.Pop()
+		left := q.q.Len()
+		q.mx.Unlock()
+		if ok {
+			if left > 0 {
+				q.signal() // wake another waiter
+			}
+			return v, nil
+		}
+		select {
+		case <-q.notify:
+		case <-ctx.Done():
+			return v, ctx.Err()
+		}
+	}
+}
+
+func (q *SyncPQ[T]) signal() {
+	select {
+	case q.notify <- struct{}{}:
+	default:
+	}
+}
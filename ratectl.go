@@ -0,0 +1,51 @@
+package xgo
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a function that delays calling fn until d has elapsed since its last invocation.
+func Debounce(d time.Duration, fn func()) func() {
+	return DebounceClock(SystemClock, d, fn)
+}
+
+// DebounceClock is Debounce measuring time by clock c.
+func DebounceClock(c Clock, d time.Duration, fn func()) func() {
+	var (
+		mx    sync.Mutex
+		timer Timer
+	)
+	return func() {
+		mx.Lock()
+		defer mx.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = c.AfterFunc(d, func() { logPanic(Call(fn)) })
+	}
+}
+
+// Throttle returns a function that calls fn at most once per d; calls within d of the last run are dropped.
+func Throttle(d time.Duration, fn func()) func() {
+	return ThrottleClock(SystemClock, d, fn)
+}
+
+// ThrottleClock is Throttle measuring time by clock c.
+func ThrottleClock(c Clock, d time.Duration, fn func()) func() {
+	var (
+		mx   sync.Mutex
+		last time.Time
+	)
+	return func() {
+		mx.Lock()
+		now := c.Now()
+		if !last.IsZero() && now.Sub(last) < d {
+			mx.Unlock()
+			return
+		}
+		last = now
+		mx.Unlock()
+		fn()
+	}
+}
@@ -0,0 +1,72 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter is a token-bucket rate limiter.
+type RateLimiter struct {
+	mx     sync.Mutex
+	rate   float64 // tokens per second
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns a limiter allowing rate events per second with bursts of at most burst events.
+func NewRateLimiter(rate float64, burst int) *RateLimiter {
+	return &RateLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Allow reports whether one event may happen now, consuming a token if so.
+func (l *RateLimiter) Allow() bool {
+	return l.TryAcquire(1)
+}
+
+// TryAcquire reports whether n events may happen now, consuming n tokens if so.
+func (l *RateLimiter) TryAcquire(n int) bool {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	l.refill()
+	if l.tokens < float64(n) {
+		return false
+	}
+	l.tokens -= float64(n)
+	return true
+}
+
+// Wait blocks until one event may happen or ctx is done.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		l.mx.Lock()
+		l.refill()
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mx.Unlock()
+			return nil
+		}
+		if l.rate <= 0 {
+			l.mx.Unlock()
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		d := time.Duration((1 - l.tokens) / l.rate * float64(time.Second))
+		l.mx.Unlock()
+		if err := SleepCtx(ctx, d); err != nil {
+			return err
+		}
+	}
+}
+
+func (l *RateLimiter) refill() {
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+}
@@ -0,0 +1,67 @@
+package xgo
+
+// Result holds either a value or an error.
+type Result[T any] struct {
+	val T
+	err error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{val: v}
+}
+
+// Err returns a failed Result holding err.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// ResultOf returns Result from v, err pair.
+func ResultOf[T any](v T, err error) Result[T] {
+	return Result[T]{val: v, err: err}
+}
+
+// IsOk reports whether r holds no error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Err returns the error of r.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns value and error of r.
+func (r Result[T]) Get() (T, error) {
+	return r.val, r.err
+}
+
+// Unwrap returns value of r or panics if r holds an error.
+func (r Result[T]) Unwrap() T {
+	noErr(r.err)
+	return r.val
+}
+
+// UnwrapOr returns value of r or fallback if r holds an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.val
+}
+
+// MapResult returns Result of fn(v) if r is ok, otherwise passes the error through.
+func MapResult[T, U any](r Result[T], fn func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(fn(r.val))
+}
+
+// AndThen returns fn(v) if r is ok, otherwise passes the error through.
+func AndThen[T, U any](r Result[T], fn func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return fn(r.val)
+}
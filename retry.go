@@ -0,0 +1,90 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// RetryOption configures Retry.
+type RetryOption func(*retryConfig)
+
+type retryConfig struct {
+	delay   func(attempt int) time.Duration
+	jitter  float64
+	retryIf func(error) bool
+	clock   Clock
+}
+
+// RetryConstant sets constant delay d between attempts.
+func RetryConstant(d time.Duration) RetryOption {
+	return func(c *retryConfig) {
+		c.delay = func(int) time.Duration { return d }
+	}
+}
+
+// RetryExponential sets delay growing exponentially from base, capped at max (non-positive max means no cap).
+func RetryExponential(base, max time.Duration) RetryOption {
+	return RetryBackoff(Backoff{Base: base, Max: max})
+}
+
+// RetryBackoff sets delays between attempts produced by b; jitter of b is applied as by RetryJitter.
+func RetryBackoff(b Backoff) RetryOption {
+	return func(c *retryConfig) {
+		c.delay = b.DelayFor
+		if b.Jitter > 0 {
+			c.jitter = b.Jitter
+		}
+	}
+}
+
+// RetryJitter adds random jitter up to factor*delay to each delay.
+func RetryJitter(factor float64) RetryOption {
+	return func(c *retryConfig) {
+		c.jitter = factor
+	}
+}
+
+// RetryIf sets predicate reporting whether the error is retryable.
+func RetryIf(retryIf func(error) bool) RetryOption {
+	return func(c *retryConfig) {
+		c.retryIf = retryIf
+	}
+}
+
+// RetryClock makes Retry measure delays by clock c.
+func RetryClock(c Clock) RetryOption {
+	return func(cfg *retryConfig) {
+		cfg.clock = c
+	}
+}
+
+// Retry calls fn up to attempts times (at least once) until it succeeds. Panics are recovered and count as failures.
+// Returns the last error, joined with ctx.Err() if ctx is done while waiting, or ctx.Err() if it is done before the first call.
+func Retry(ctx context.Context, attempts int, fn func() error, opts ...RetryOption) (err error) {
+	c := retryConfig{delay: func(int) time.Duration { return 0 }}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if err = ctx.Err(); err != nil {
+		return
+	}
+	attempts = max(attempts, 1)
+	for i := 0; i < attempts; i++ {
+		if err = callErr(fn); err == nil {
+			return nil
+		}
+		if c.retryIf != nil && !c.retryIf(err) || i == attempts-1 {
+			break
+		}
+		d := c.delay(i)
+		if c.jitter > 0 && d > 0 {
+			d += time.Duration(rand.Float64() * c.jitter * float64(d))
+		}
+		if e := sleepClock(ctx, c.clock, d); e != nil {
+			return errors.Join(err, e)
+		}
+	}
+	return
+}
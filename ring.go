@@ -0,0 +1,129 @@
+package xgo
+
+import "context"
+
+// Ring is a fixed-capacity circular buffer; pushing to a full ring overwrites the oldest value.
+// Ring is not safe for concurrent use.
+type Ring[T any] struct {
+	buf  []T
+	head int // index of the oldest value
+	size int
+}
+
+// NewRing returns a ring of given capacity.
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity <= 0 {
+		panic("xgo: non-positive ring capacity")
+	}
+	return &Ring[T]{buf: make([]T, capacity)}
+}
+
+// Len returns the number of values in r.
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the capacity of r.
+func (r *Ring[T]) Cap() int {
+	return len(r.buf)
+}
+
+// Push appends v to r and reports whether the oldest value was overwritten.
+func (r *Ring[T]) Push(v T) (overwritten bool) {
+	r.buf[(r.head+r.size)%len(r.buf)] = v
+	if r.size == len(r.buf) {
+		r.head = (r.head + 1) % len(r.buf)
+		return true
+	}
+	r.size++
+	return false
+}
+
+// Pop removes and returns the oldest value of r; ok is false if r is empty.
+func (r *Ring[T]) Pop() (v T, ok bool) {
+	if r.size == 0 {
+		return
+	}
+	var v0 T
+	v, r.buf[r.head] = r.buf[r.head], v0
+	r.head = (r.head + 1) % len(r.buf)
+	r.size--
+	return v, true
+}
+
+// Peek returns the oldest value of r without removing it; ok is false if r is empty.
+func (r *Ring[T]) Peek() (v T, ok bool) {
+	if r.size == 0 {
+		return
+	}
+	return r.buf[r.head], true
+}
+
+// ToSlice returns values of r from the oldest to the newest.
+func (r *Ring[T]) ToSlice() []T {
+	res := make([]T, r.size)
+	for i := range res {
+		res[i] = r.buf[(r.head+i)%len(r.buf)]
+	}
+	return res
+}
+
+// BoundedQueue is a FIFO queue of limited capacity safe for concurrent use.
+type BoundedQueue[T any] struct {
+	ch chan T
+}
+
+// NewBoundedQueue returns a queue of given capacity.
+func NewBoundedQueue[T any](capacity int) *BoundedQueue[T] {
+	return &BoundedQueue[T]{ch: make(chan T, capacity)}
+}
+
+// Len returns the number of values in q.
+func (q *BoundedQueue[T]) Len() int {
+	return len(q.ch)
+}
+
+// Cap returns the capacity of q.
+func (q *BoundedQueue[T]) Cap() int {
+	return cap(q.ch)
+}
+
+// Push appends v to q, blocking while q is full.
+func (q *BoundedQueue[T]) Push(v T) {
+	q.ch <- v
+}
+
+// TryPush appends v to q if it is not full and reports whether it did.
+func (q *BoundedQueue[T]) TryPush(v T) bool {
+	select {
+	case q.ch <- v:
+		return true
+	default:
+		return false
+	}
+}
+
+// PushCtx appends v to q, blocking while q is full or until ctx is done.
+func (q *BoundedQueue[T]) PushCtx(ctx context.Context, v T) error {
+	return SendCtx(ctx, q.ch, v)
+}
+
+// Pop removes and returns the oldest value of q, blocking while q is empty.
+func (q *BoundedQueue[T]) Pop() T {
+	return <-q.ch
+}
+
+// TryPop removes and returns the oldest value of q if it is not empty; ok reports whether it did.
+func (q *BoundedQueue[T]) TryPop() (v T, ok bool) {
+	select {
+	case v = <-q.ch:
+		return v, true
+	default:
+		return
+	}
+}
+
+// PopCtx removes and returns the oldest value of q, blocking while q is empty or until ctx is done.
+func (q *BoundedQueue[T]) PopCtx(ctx context.Context) (T, error) {
+	return RecvCtx(ctx, q.ch)
+}
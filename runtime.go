@@ -2,6 +2,7 @@ package xgo
 
 import (
 	"path/filepath"
+	"reflect"
 	"runtime"
 )
 
@@ -15,3 +16,61 @@ func RuntimeDir() string {
 	_, file, _, _ := runtime.Caller(1)
 	return filepath.Dir(file)
 }
+
+// Frame is a stack frame.
+type Frame struct {
+	Func string
+	File string
+	Line int
+}
+
+// CallerName returns the function name of the caller skip frames above the caller of CallerName.
+func CallerName(skip int) string {
+	pc, _, _, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return ""
+	}
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		return fn.Name()
+	}
+	return ""
+}
+
+// CallerLocation returns file and line of the caller skip frames above the caller of CallerLocation.
+func CallerLocation(skip int) (file string, line int) {
+	_, file, line, _ = runtime.Caller(skip + 1)
+	return
+}
+
+// StackTrace returns at most max frames of the stack starting skip frames above the caller of StackTrace.
+func StackTrace(skip, max int) []Frame {
+	if max <= 0 {
+		return nil
+	}
+	pcs := make([]uintptr, max)
+	n := runtime.Callers(skip+2, pcs)
+	if n == 0 {
+		return nil
+	}
+	frames := runtime.CallersFrames(pcs[:n])
+	res := make([]Frame, 0, n)
+	for {
+		f, more := frames.Next()
+		res = append(res, Frame{Func: f.Function, File: f.File, Line: f.Line})
+		if !more || len(res) == max {
+			return res
+		}
+	}
+}
+
+// FuncName returns the name of function fn.
+func FuncName(fn any) string {
+	v := reflect.ValueOf(fn)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+	if f := runtime.FuncForPC(v.Pointer()); f != nil {
+		return f.Name()
+	}
+	return ""
+}
@@ -0,0 +1,94 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+// EveryOption configures Every.
+type EveryOption func(*everyConfig)
+
+type everyConfig struct {
+	jitter    float64
+	immediate bool
+	onError   func(error)
+	clock     Clock
+}
+
+// EveryJitter adds random jitter up to factor*interval to each wait.
+func EveryJitter(factor float64) EveryOption {
+	return func(c *everyConfig) {
+		c.jitter = factor
+	}
+}
+
+// EveryImmediate makes Every run fn immediately instead of waiting for the first interval.
+func EveryImmediate() EveryOption {
+	return func(c *everyConfig) {
+		c.immediate = true
+	}
+}
+
+// EveryOnError sets handler of errors and recovered panics of fn.
+func EveryOnError(onError func(error)) EveryOption {
+	return func(c *everyConfig) {
+		c.onError = onError
+	}
+}
+
+// EveryClock makes Every measure intervals by clock c.
+func EveryClock(c Clock) EveryOption {
+	return func(cfg *everyConfig) {
+		cfg.clock = c
+	}
+}
+
+// Every runs fn safely every interval until ctx is done, then returns ctx.Err().
+// Errors of fn don't stop the loop; they are passed to the EveryOnError handler if set,
+// otherwise panics are reported to the logger set by SetLogger.
+func Every(ctx context.Context, interval time.Duration, fn func(context.Context) error, opts ...EveryOption) error {
+	var c everyConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	for first := true; ; first = false {
+		if !first || !c.immediate {
+			d := interval
+			if c.jitter > 0 {
+				d += time.Duration(rand.Float64() * c.jitter * float64(interval))
+			}
+			if err := sleepClock(ctx, c.clock, d); err != nil {
+				return err
+			}
+		}
+		if err := callErr(func() error { return fn(ctx) }); err != nil && c.onError != nil {
+			c.onError(err)
+		} else {
+			logPanic(err)
+		}
+	}
+}
+
+// At runs fn safely at each of the given times in chronological order; times already passed are skipped.
+// Returns joined errors of fn, joined with ctx.Err() if ctx is done before the last run.
+func At(ctx context.Context, fn func(context.Context) error, times ...time.Time) error {
+	times = slices.Clone(times)
+	slices.SortFunc(times, time.Time.Compare)
+	var errs []error
+	for _, t := range times {
+		d := time.Until(t)
+		if d < 0 {
+			continue
+		}
+		if err := SleepCtx(ctx, d); err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		if err := callErr(func() error { return fn(ctx) }); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
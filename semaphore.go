@@ -0,0 +1,51 @@
+package xgo
+
+import "context"
+
+// Semaphore limits the number of concurrent holders.
+type Semaphore struct {
+	ch chan struct{}
+}
+
+// NewSemaphore returns a semaphore with n slots.
+func NewSemaphore(n int) *Semaphore {
+	return &Semaphore{ch: make(chan struct{}, n)}
+}
+
+// Acquire takes a slot, blocking until one is free or ctx is done.
+func (s *Semaphore) Acquire(ctx context.Context) error {
+	select {
+	case s.ch <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// TryAcquire takes a slot if one is free and reports whether it did.
+func (s *Semaphore) TryAcquire() bool {
+	select {
+	case s.ch <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+// Release frees a slot taken by Acquire or TryAcquire.
+func (s *Semaphore) Release() {
+	select {
+	case <-s.ch:
+	default:
+		panic("xgo: semaphore released without acquire")
+	}
+}
+
+// With acquires a slot, runs fn safely and releases the slot. Returns ctx.Err() or the recovered panic.
+func (s *Semaphore) With(ctx context.Context, fn func()) error {
+	if err := s.Acquire(ctx); err != nil {
+		return err
+	}
+	defer s.Release()
+	return Call(fn)
+}
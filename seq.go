@@ -0,0 +1,95 @@
+package xgo
+
+import (
+	"context"
+	"iter"
+)
+
+// SeqFromSlice returns a sequence of elements of s.
+func SeqFromSlice[T any](s []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqMap returns a sequence of fn applied to each value of seq.
+func SeqMap[T, U any](seq iter.Seq[T], fn func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// SeqFilter returns a sequence of values of seq satisfying filter(v).
+func SeqFilter[T any](seq iter.Seq[T], filter func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if filter(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqTake returns a sequence of the first n values of seq.
+func SeqTake[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// CollectSeq returns all values of seq as a slice.
+func CollectSeq[T any](seq iter.Seq[T]) []T {
+	var res []T
+	for v := range seq {
+		res = append(res, v)
+	}
+	return res
+}
+
+// SeqFromChan returns a sequence of values received from ch until it is closed or ctx is done.
+func SeqFromChan[T any](ctx context.Context, ch <-chan T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for {
+			v, err := RecvCtx(ctx, ch)
+			if err != nil || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SeqToChan sends values of seq to the returned channel from a new goroutine.
+// The channel is closed when seq ends, ctx is done or seq panics.
+func SeqToChan[T any](ctx context.Context, seq iter.Seq[T]) <-chan T {
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		logPanic(Call(func() {
+			for v := range seq {
+				if SendCtx(ctx, ch, v) != nil {
+					return
+				}
+			}
+		}))
+	}()
+	return ch
+}
@@ -0,0 +1,137 @@
+package xgo
+
+import "sync"
+
+// Set is a set of comparable values.
+type Set[T comparable] map[T]struct{}
+
+// NewSet returns a set of values.
+func NewSet[T comparable](values ...T) Set[T] {
+	s := make(Set[T], len(values))
+	s.Add(values...)
+	return s
+}
+
+// Add adds values to s.
+func (s Set[T]) Add(values ...T) {
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+}
+
+// Has reports whether v is present in s.
+func (s Set[T]) Has(v T) bool {
+	_, ok := s[v]
+	return ok
+}
+
+// Delete removes values from s.
+func (s Set[T]) Delete(values ...T) {
+	for _, v := range values {
+		delete(s, v)
+	}
+}
+
+// Len returns number of values in s.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Union returns a new set of values present in s or in t.
+func (s Set[T]) Union(t Set[T]) Set[T] {
+	res := make(Set[T], len(s)+len(t))
+	for v := range s {
+		res[v] = struct{}{}
+	}
+	for v := range t {
+		res[v] = struct{}{}
+	}
+	return res
+}
+
+// Intersect returns a new set of values present in both s and t.
+func (s Set[T]) Intersect(t Set[T]) Set[T] {
+	res := Set[T]{}
+	for v := range s {
+		if t.Has(v) {
+			res[v] = struct{}{}
+		}
+	}
+	return res
+}
+
+// Diff returns a new set of values present in s but not in t.
+func (s Set[T]) Diff(t Set[T]) Set[T] {
+	res := Set[T]{}
+	for v := range s {
+		if !t.Has(v) {
+			res[v] = struct{}{}
+		}
+	}
+	return res
+}
+
+// ToSlice returns values of s in unspecified order.
+func (s Set[T]) ToSlice() []T {
+	res := make([]T, 0, len(s))
+	for v := range s {
+		res = append(res, v)
+	}
+	return res
+}
+
+// SyncSet is a set of comparable values safe for concurrent use.
+type SyncSet[T comparable] struct {
+	mx sync.RWMutex
+	s  Set[T]
+}
+
+// NewSyncSet returns a concurrent-safe set of values.
+func NewSyncSet[T comparable](values ...T) *SyncSet[T] {
+	return &SyncSet[T]{s: NewSet(values...)}
+}
+
+// Add adds values to s.
+func (s *SyncSet[T]) Add(values ...T) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	if s.s == nil {
+		s.s = Set[T]{}
+	}
+	s.s.Add(values...)
+}
+
+// Has reports whether v is present in s.
+func (s *SyncSet[T]) Has(v T) bool {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	return s.s.Has(v)
+}
+
+// Delete removes values from s.
+func (s *SyncSet[T]) Delete(values ...T) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.s.Delete(values...)
+}
+
+// Len returns number of values in s.
+func (s *SyncSet[T]) Len() int {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	return len(s.s)
+}
+
+// Snapshot returns a copy of values of s as Set.
+func (s *SyncSet[T]) Snapshot() Set[T] {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	return s.s.Union(nil)
+}
+
+// ToSlice returns values of s in unspecified order.
+func (s *SyncSet[T]) ToSlice() []T {
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	return s.s.ToSlice()
+}
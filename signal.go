@@ -0,0 +1,29 @@
+package xgo
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+)
+
+var defaultSignals = []os.Signal{os.Interrupt, syscall.SIGTERM}
+
+// WaitForSignal blocks until one of the signals (SIGINT, SIGTERM by default) is received and returns it.
+func WaitForSignal(sig ...os.Signal) os.Signal {
+	if len(sig) == 0 {
+		sig = defaultSignals
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sig...)
+	defer signal.Stop(ch)
+	return <-ch
+}
+
+// SignalContext returns a copy of ctx that is canceled when one of the signals (SIGINT, SIGTERM by default) is received.
+func SignalContext(ctx context.Context, sig ...os.Signal) (context.Context, context.CancelFunc) {
+	if len(sig) == 0 {
+		sig = defaultSignals
+	}
+	return signal.NotifyContext(ctx, sig...)
+}
@@ -0,0 +1,260 @@
+package xgo
+
+import (
+	"cmp"
+	"math/rand/v2"
+	"slices"
+)
+
+// MapSlice returns a new slice with fn applied to each element of s.
+func MapSlice[T, U any](s []T, fn func(T) U) []U {
+	if s == nil {
+		return nil
+	}
+	res := make([]U, len(s))
+	for i, v := range s {
+		res[i] = fn(v)
+	}
+	return res
+}
+
+// FilterSlice returns a new slice with elements of s satisfying filter(v).
+func FilterSlice[T any](s []T, filter func(T) bool) []T {
+	var res []T
+	for _, v := range s {
+		if filter(v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Reduce folds s into a single value starting from init.
+func Reduce[T, A any](s []T, init A, fn func(A, T) A) A {
+	acc := init
+	for _, v := range s {
+		acc = fn(acc, v)
+	}
+	return acc
+}
+
+// Each calls fn for each element of s.
+func Each[T any](s []T, fn func(T)) {
+	for _, v := range s {
+		fn(v)
+	}
+}
+
+// Chunk splits s into consecutive chunks of the given size; the last chunk may be smaller.
+// Chunks share the underlying array of s.
+func Chunk[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("xgo: non-positive chunk size")
+	}
+	res := make([][]T, 0, (len(s)+size-1)/size)
+	for size < len(s) {
+		res = append(res, s[:size:size])
+		s = s[size:]
+	}
+	if len(s) > 0 {
+		res = append(res, s)
+	}
+	return res
+}
+
+// Windows returns all consecutive sub-slices of s of the given size, each shifted by one element.
+// It returns no windows if s is shorter than size. Windows share the underlying array of s.
+func Windows[T any](s []T, size int) [][]T {
+	if size <= 0 {
+		panic("xgo: non-positive window size")
+	}
+	if len(s) < size {
+		return [][]T{}
+	}
+	res := make([][]T, 0, len(s)-size+1)
+	for i := 0; i+size <= len(s); i++ {
+		res = append(res, s[i:i+size:i+size])
+	}
+	return res
+}
+
+// Pairwise returns pairs of consecutive elements of s: (s[0], s[1]), (s[1], s[2]), ...
+func Pairwise[T any](s []T) []Pair[T, T] {
+	if len(s) < 2 {
+		return []Pair[T, T]{}
+	}
+	res := make([]Pair[T, T], len(s)-1)
+	for i := range res {
+		res[i] = Pair[T, T]{s[i], s[i+1]}
+	}
+	return res
+}
+
+// Unique returns a new slice with elements of s without duplicates, keeping the first occurrence order.
+func Unique[T comparable](s []T) []T {
+	if s == nil {
+		return nil
+	}
+	seen := make(map[T]struct{}, len(s))
+	res := make([]T, 0, len(s))
+	for _, v := range s {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Reverse reverses elements of s in place.
+func Reverse[T any](s []T) {
+	slices.Reverse(s)
+}
+
+// Reversed returns a reversed copy of s.
+func Reversed[T any](s []T) []T {
+	res := slices.Clone(s)
+	slices.Reverse(res)
+	return res
+}
+
+// Shuffle shuffles elements of s in place using optional random source r.
+func Shuffle[T any](s []T, r ...*rand.Rand) {
+	swap := func(i, j int) { s[i], s[j] = s[j], s[i] }
+	if len(r) > 0 && r[0] != nil {
+		r[0].Shuffle(len(s), swap)
+		return
+	}
+	rand.Shuffle(len(s), swap)
+}
+
+// SortBy sorts s in place by key(v) in ascending order, keeping the order of equal elements.
+func SortBy[T any, K cmp.Ordered](s []T, key func(T) K) {
+	slices.SortStableFunc(s, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// SortStableBy is an alias of SortBy, which is stable.
+func SortStableBy[T any, K cmp.Ordered](s []T, key func(T) K) {
+	SortBy(s, key)
+}
+
+// BinarySearchBy searches target in s sorted by key(v) in ascending order.
+// Returns the position where target is found, or would be inserted, and whether it is found.
+func BinarySearchBy[T any, K cmp.Ordered](s []T, target K, key func(T) K) (int, bool) {
+	return slices.BinarySearchFunc(s, target, func(v T, target K) int {
+		return cmp.Compare(key(v), target)
+	})
+}
+
+// MinBy returns the element of s with the smallest key(v); ok is false if s is empty.
+func MinBy[T any, K cmp.Ordered](s []T, key func(T) K) (v T, ok bool) {
+	var k K
+	for i, x := range s {
+		if kx := key(x); i == 0 || kx < k {
+			v, k = x, kx
+		}
+	}
+	return v, len(s) > 0
+}
+
+// MaxBy returns the element of s with the largest key(v); ok is false if s is empty.
+func MaxBy[T any, K cmp.Ordered](s []T, key func(T) K) (v T, ok bool) {
+	var k K
+	for i, x := range s {
+		if kx := key(x); i == 0 || kx > k {
+			v, k = x, kx
+		}
+	}
+	return v, len(s) > 0
+}
+
+// GroupBy returns elements of s grouped by key(v), keeping their order within groups.
+func GroupBy[T any, K comparable](s []T, key func(T) K) map[K][]T {
+	res := map[K][]T{}
+	for _, v := range s {
+		k := key(v)
+		res[k] = append(res[k], v)
+	}
+	return res
+}
+
+// Partition splits s into elements satisfying pred(v) and the rest.
+func Partition[T any](s []T, pred func(T) bool) (yes, no []T) {
+	for _, v := range s {
+		if pred(v) {
+			yes = append(yes, v)
+		} else {
+			no = append(no, v)
+		}
+	}
+	return
+}
+
+// CountBy returns numbers of elements of s by key(v).
+func CountBy[T any, K comparable](s []T, key func(T) K) map[K]int {
+	res := map[K]int{}
+	for _, v := range s {
+		res[key(v)]++
+	}
+	return res
+}
+
+// InsertAt inserts values into s at index i in place and returns the modified slice.
+func InsertAt[T any](s []T, i int, values ...T) []T {
+	return slices.Insert(s, i, values...)
+}
+
+// InsertAtCopy returns a copy of s with values inserted at index i; s is not modified.
+func InsertAtCopy[T any](s []T, i int, values ...T) []T {
+	res := make([]T, 0, len(s)+len(values))
+	res = append(res, s[:i]...)
+	res = append(res, values...)
+	return append(res, s[i:]...)
+}
+
+// RemoveAt removes the element at index i from s in place, keeping order, and returns the modified slice.
+func RemoveAt[T any](s []T, i int) []T {
+	return slices.Delete(s, i, i+1)
+}
+
+// RemoveAtCopy returns a copy of s without the element at index i; s is not modified.
+func RemoveAtCopy[T any](s []T, i int) []T {
+	_ = s[i] // bounds check
+	res := make([]T, 0, len(s)-1)
+	res = append(res, s[:i]...)
+	return append(res, s[i+1:]...)
+}
+
+// RemoveFirst removes the first occurrence of v from s in place, keeping order, and returns the modified slice.
+func RemoveFirst[T comparable](s []T, v T) []T {
+	if i := slices.Index(s, v); i >= 0 {
+		return slices.Delete(s, i, i+1)
+	}
+	return s
+}
+
+// RemoveFirstCopy returns a copy of s without the first occurrence of v; s is not modified.
+func RemoveFirstCopy[T comparable](s []T, v T) []T {
+	if i := slices.Index(s, v); i >= 0 {
+		return RemoveAtCopy(s, i)
+	}
+	return slices.Clone(s)
+}
+
+// RemoveAll removes elements satisfying pred(v) from s in place, keeping order, and returns the modified slice.
+func RemoveAll[T any](s []T, pred func(T) bool) []T {
+	return slices.DeleteFunc(s, pred)
+}
+
+// RemoveAllCopy returns a copy of s without elements satisfying pred(v); s is not modified.
+func RemoveAllCopy[T any](s []T, pred func(T) bool) []T {
+	res := make([]T, 0, len(s))
+	for _, v := range s {
+		if !pred(v) {
+			res = append(res, v)
+		}
+	}
+	return res
+}
@@ -0,0 +1,271 @@
+package xgo
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// Stream is a stage of a channel-based pipeline.
+// A panic in any stage stops the pipeline; the error is reported by Err.
+type Stream[T any] struct {
+	ch   <-chan T
+	p    *pipe
+	done chan struct{} // closed when consumer stops reading
+	once sync.Once
+}
+
+type pipe struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	parents []*pipe
+	mx      sync.Mutex
+	errs    []error
+}
+
+func newPipe(ctx context.Context, parents ...*pipe) *pipe {
+	ctx, cancel := context.WithCancel(ctx)
+	return &pipe{ctx: ctx, cancel: cancel, parents: parents}
+}
+
+func (p *pipe) fail(err error) {
+	p.mx.Lock()
+	p.errs = append(p.errs, err)
+	p.mx.Unlock()
+	p.stop()
+}
+
+func (p *pipe) stop() {
+	p.cancel()
+	for _, parent := range p.parents {
+		parent.stop()
+	}
+}
+
+func (p *pipe) err() error {
+	p.mx.Lock()
+	errs := append([]error{}, p.errs...)
+	p.mx.Unlock()
+	for _, parent := range p.parents {
+		errs = append(errs, parent.err())
+	}
+	return errors.Join(errs...)
+}
+
+func newStream[T any](p *pipe, run func(emit func(T) bool)) *Stream[T] {
+	ch := make(chan T)
+	s := &Stream[T]{ch: ch, p: p, done: make(chan struct{})}
+	go func() {
+		defer close(ch)
+		emit := func(v T) bool {
+			select {
+			case ch <- v:
+				return true
+			case <-s.done:
+			case <-p.ctx.Done():
+			}
+			return false
+		}
+		if err := Call(func() { run(emit) }); err != nil {
+			p.fail(err)
+		}
+	}()
+	return s
+}
+
+// FromSlice returns a stream of elements of s.
+func FromSlice[T any](ctx context.Context, s []T) *Stream[T] {
+	return newStream(newPipe(ctx), func(emit func(T) bool) {
+		for _, v := range s {
+			if !emit(v) {
+				return
+			}
+		}
+	})
+}
+
+// FromChan returns a stream of values received from ch until it is closed.
+func FromChan[T any](ctx context.Context, ch <-chan T) *Stream[T] {
+	p := newPipe(ctx)
+	return newStream(p, func(emit func(T) bool) {
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok || !emit(v) {
+					return
+				}
+			case <-p.ctx.Done():
+				return
+			}
+		}
+	})
+}
+
+// Chan returns the output channel of the stream.
+func (s *Stream[T]) Chan() <-chan T {
+	return s.ch
+}
+
+// Stop stops the stream; upstream stages exit once they have no other consumers.
+func (s *Stream[T]) Stop() {
+	s.once.Do(func() { close(s.done) })
+}
+
+// Err returns joined errors of the pipeline stages.
+func (s *Stream[T]) Err() error {
+	return s.p.err()
+}
+
+// Collect reads all values of the stream and returns them with the pipeline error.
+func (s *Stream[T]) Collect() ([]T, error) {
+	var res []T
+	for v := range s.ch {
+		res = append(res, v)
+	}
+	return res, s.Err()
+}
+
+// Filter returns a stream of values satisfying filter(v).
+func (s *Stream[T]) Filter(filter func(T) bool) *Stream[T] {
+	return newStream(s.p, func(emit func(T) bool) {
+		defer s.Stop()
+		for v := range s.ch {
+			if filter(v) && !emit(v) {
+				return
+			}
+		}
+	})
+}
+
+// Take returns a stream of the first n values.
+func (s *Stream[T]) Take(n int) *Stream[T] {
+	return newStream(s.p, func(emit func(T) bool) {
+		defer s.Stop()
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range s.ch {
+			if !emit(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	})
+}
+
+// FanOut returns n streams concurrently reading values of s; each value goes to one of them.
+func (s *Stream[T]) FanOut(n int) []*Stream[T] {
+	res := make([]*Stream[T], n)
+	var active atomic.Int32
+	active.Store(int32(n))
+	for i := range res {
+		res[i] = newStream(s.p, func(emit func(T) bool) {
+			defer func() {
+				if active.Add(-1) == 0 {
+					s.Stop()
+				}
+			}()
+			for v := range s.ch {
+				if !emit(v) {
+					return
+				}
+			}
+		})
+	}
+	return res
+}
+
+// StreamMap returns a stream of fn applied to each value of s.
+func StreamMap[T, U any](s *Stream[T], fn func(T) U) *Stream[U] {
+	return newStream(s.p, func(emit func(U) bool) {
+		defer s.Stop()
+		for v := range s.ch {
+			if !emit(fn(v)) {
+				return
+			}
+		}
+	})
+}
+
+// StreamBatch returns a stream of values of s grouped in slices of the given size; the last batch may be smaller.
+func StreamBatch[T any](s *Stream[T], size int) *Stream[[]T] {
+	return newStream(s.p, func(emit func([]T) bool) {
+		defer s.Stop()
+		var batch []T
+		for v := range s.ch {
+			if batch = append(batch, v); len(batch) >= size {
+				if !emit(batch) {
+					return
+				}
+				batch = nil
+			}
+		}
+		if len(batch) > 0 {
+			emit(batch)
+		}
+	})
+}
+
+// Merge returns a stream of values of all streams interleaved.
+func Merge[T any](streams ...*Stream[T]) *Stream[T] {
+	ctx := context.Background()
+	var parents []*pipe
+	for _, s := range streams {
+		if !In(s.p, parents...) {
+			parents = append(parents, s.p)
+		}
+	}
+	if len(streams) > 0 {
+		ctx = streams[0].p.ctx
+	}
+	return newStream(newPipe(ctx, parents...), func(emit func(T) bool) {
+		var stopped atomic.Bool
+		var wg sync.WaitGroup
+		wg.Add(len(streams))
+		for _, s := range streams {
+			go func(s *Stream[T]) {
+				defer wg.Done()
+				defer s.Stop()
+				for v := range s.ch {
+					if stopped.Load() || !emit(v) {
+						stopped.Store(true)
+						return
+					}
+				}
+			}(s)
+		}
+		wg.Wait()
+	})
+}
+
+// Results holds values processed by CollectResults and errors of failed items keyed by their index in the stream.
+type Results[T any] struct {
+	Values []T
+	Errors map[int]error
+}
+
+// CollectResults reads all values of s and applies fn to each of them. Failed or panicking items don't stop
+// the pipeline; their errors are collected in Results.Errors. The returned error is the pipeline error.
+func CollectResults[T, U any](s *Stream[T], fn func(T) (U, error)) (Results[U], error) {
+	defer s.Stop()
+	res := Results[U]{Errors: map[int]error{}}
+	i := 0
+	for v := range s.ch {
+		var u U
+		err := callErr(func() (err error) {
+			u, err = fn(v)
+			return
+		})
+		if err != nil {
+			res.Errors[i] = err
+		} else {
+			res.Values = append(res.Values, u)
+		}
+		i++
+	}
+	return res, s.Err()
+}
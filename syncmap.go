@@ -0,0 +1,63 @@
+package xgo
+
+import "sync"
+
+// SyncMap is a typed wrapper of sync.Map.
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Load returns the value stored for key and reports whether it is present.
+func (m *SyncMap[K, V]) Load(key K) (v V, ok bool) {
+	val, ok := m.m.Load(key)
+	if ok {
+		v, _ = val.(V) // nil interface value
+	}
+	return
+}
+
+// Store sets the value for key.
+func (m *SyncMap[K, V]) Store(key K, v V) {
+	m.m.Store(key, v)
+}
+
+// LoadOrStore returns the existing value for key if present, otherwise stores and returns v.
+// The loaded result is true if the value was loaded, false if stored.
+func (m *SyncMap[K, V]) LoadOrStore(key K, v V) (actual V, loaded bool) {
+	val, loaded := m.m.LoadOrStore(key, v)
+	actual, _ = val.(V)
+	return actual, loaded
+}
+
+// LoadAndDelete deletes the value for key, returning the previous value if any.
+func (m *SyncMap[K, V]) LoadAndDelete(key K) (v V, loaded bool) {
+	val, loaded := m.m.LoadAndDelete(key)
+	if loaded {
+		v, _ = val.(V)
+	}
+	return
+}
+
+// Delete deletes the value for key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// Range calls fn for each key and value in the map until fn returns false.
+func (m *SyncMap[K, V]) Range(fn func(key K, v V) bool) {
+	m.m.Range(func(key, val any) bool {
+		k, _ := key.(K)
+		v, _ := val.(V)
+		return fn(k, v)
+	})
+}
+
+// GetOrCompute returns the existing value for key if present, otherwise stores and returns compute().
+// Under concurrent calls compute may run more than once, but only one result is stored.
+func (m *SyncMap[K, V]) GetOrCompute(key K, compute func() V) V {
+	if v, ok := m.Load(key); ok {
+		return v
+	}
+	v, _ := m.LoadOrStore(key, compute())
+	return v
+}
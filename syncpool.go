@@ -0,0 +1,36 @@
+package xgo
+
+import "sync"
+
+// SyncPool is a typed wrapper of sync.Pool holding *T values.
+type SyncPool[T any] struct {
+	p     sync.Pool
+	reset func(*T)
+}
+
+// NewSyncPool returns a pool creating values by newFn (new(T) if nil) and calling reset (if not nil) on Put.
+func NewSyncPool[T any](newFn func() *T, reset func(*T)) *SyncPool[T] {
+	if newFn == nil {
+		newFn = func() *T { return new(T) }
+	}
+	return &SyncPool[T]{
+		p:     sync.Pool{New: func() any { return newFn() }},
+		reset: reset,
+	}
+}
+
+// Get returns a value from the pool, creating one if the pool is empty.
+func (p *SyncPool[T]) Get() *T {
+	return p.p.Get().(*T)
+}
+
+// Put resets v and returns it to the pool. Nil v is ignored.
+func (p *SyncPool[T]) Put(v *T) {
+	if v == nil {
+		return
+	}
+	if p.reset != nil {
+		p.reset(v)
+	}
+	p.p.Put(v)
+}
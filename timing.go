@@ -0,0 +1,89 @@
+package xgo
+
+import (
+	"log"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// TimeIt returns duration of fn execution.
+func TimeIt(fn func()) time.Duration {
+	start := time.Now()
+	fn()
+	return time.Since(start)
+}
+
+var measureSink atomic.Pointer[func(name string, d time.Duration)]
+
+// SetMeasureSink sets the destination of Measure reports. Nil sink restores the default one writing to log.
+func SetMeasureSink(sink func(name string, d time.Duration)) {
+	if sink == nil {
+		measureSink.Store(nil)
+		return
+	}
+	measureSink.Store(&sink)
+}
+
+// Measure runs fn and reports its duration under name to the measure sink.
+// The duration is reported even if fn panics.
+func Measure(name string, fn func()) {
+	start := time.Now()
+	defer func() {
+		d := time.Since(start)
+		if sink := measureSink.Load(); sink != nil {
+			(*sink)(name, d)
+		} else {
+			log.Printf("%s: %v", name, d)
+		}
+	}()
+	fn()
+}
+
+// Stopwatch measures elapsed time and laps. It is safe for concurrent use.
+type Stopwatch struct {
+	mx    sync.Mutex
+	start time.Time
+	last  time.Time
+	laps  []time.Duration
+}
+
+// NewStopwatch returns a started stopwatch.
+func NewStopwatch() *Stopwatch {
+	now := time.Now()
+	return &Stopwatch{start: now, last: now}
+}
+
+// Lap records and returns time elapsed since the previous lap or the start.
+func (s *Stopwatch) Lap() time.Duration {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	now := time.Now()
+	d := now.Sub(s.last)
+	s.last = now
+	s.laps = append(s.laps, d)
+	return d
+}
+
+// Laps returns durations of recorded laps.
+func (s *Stopwatch) Laps() []time.Duration {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return append([]time.Duration{}, s.laps...)
+}
+
+// Elapsed returns time elapsed since the start.
+func (s *Stopwatch) Elapsed() time.Duration {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	return time.Since(s.start)
+}
+
+// Reset restarts the stopwatch and clears laps.
+func (s *Stopwatch) Reset() {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+	s.start = time.Now()
+	s.last = s.start
+	s.laps = nil
+}
@@ -0,0 +1,31 @@
+package xgo
+
+// Attempt is a result of Try used for structured Catch/Finally flow.
+type Attempt struct {
+	err error
+}
+
+// Try runs fn safely right away; a panic in fn is converted to an error.
+// Use as Try(fn).Catch(handler).Finally(cleanup).
+func Try(fn func() error) *Attempt {
+	return &Attempt{err: callErr(fn)}
+}
+
+// Catch calls handler with the error of the attempt, if any.
+func (a *Attempt) Catch(handler func(error)) *Attempt {
+	if a.err != nil {
+		handler(a.err)
+	}
+	return a
+}
+
+// Finally calls fn and returns the error of the attempt.
+func (a *Attempt) Finally(fn func()) error {
+	fn()
+	return a.err
+}
+
+// Err returns the error of the attempt.
+func (a *Attempt) Err() error {
+	return a.err
+}
@@ -0,0 +1,119 @@
+package xgo
+
+import (
+	"sync"
+	"time"
+)
+
+// TTLMap is a concurrent-safe map whose entries expire after a fixed duration.
+// Expired entries are evicted by a background janitor until Close is called.
+type TTLMap[K comparable, V any] struct {
+	mx      sync.Mutex
+	ttl     time.Duration
+	items   map[K]ttlEntry[V]
+	onEvict func(K, V)
+	stop    chan struct{}
+	once    sync.Once
+}
+
+type ttlEntry[V any] struct {
+	val     V
+	expires time.Time
+}
+
+// NewTTLMap returns a map with entries living for ttl, evicted every interval (ttl, but at least a second, if non-positive).
+// onEvict, if not nil, is called for each expired entry removed by the janitor.
+func NewTTLMap[K comparable, V any](ttl, interval time.Duration, onEvict func(K, V)) *TTLMap[K, V] {
+	m := &TTLMap[K, V]{
+		ttl:     ttl,
+		items:   map[K]ttlEntry[V]{},
+		onEvict: onEvict,
+		stop:    make(chan struct{}),
+	}
+	if interval <= 0 {
+		interval = max(ttl, time.Second)
+	}
+	Go(func() { m.janitor(interval) })
+	return m
+}
+
+func (m *TTLMap[K, V]) janitor(interval time.Duration) {
+	t := time.NewTicker(interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.stop:
+			return
+		case <-t.C:
+			m.evict()
+		}
+	}
+}
+
+func (m *TTLMap[K, V]) evict() {
+	now := time.Now()
+	var evicted []Pair[K, V]
+	m.mx.Lock()
+	for k, e := range m.items {
+		if now.After(e.expires) {
+			delete(m.items, k)
+			evicted = append(evicted, MakePair(k, e.val))
+		}
+	}
+	m.mx.Unlock()
+	if m.onEvict != nil {
+		for _, p := range evicted {
+			logPanic(Call(func() { m.onEvict(p.Unpack()) }))
+		}
+	}
+}
+
+// Get returns the value for key and reports whether it is present and not expired.
+func (m *TTLMap[K, V]) Get(key K) (v V, ok bool) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	e, ok := m.items[key]
+	if !ok || time.Now().After(e.expires) {
+		return v, false
+	}
+	return e.val, true
+}
+
+// Set stores v for key, resetting its expiration.
+func (m *TTLMap[K, V]) Set(key K, v V) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	m.items[key] = ttlEntry[V]{val: v, expires: time.Now().Add(m.ttl)}
+}
+
+// GetOrSet returns the existing value for key if present, otherwise stores and returns v.
+// The loaded result is true if the value was loaded, false if stored.
+func (m *TTLMap[K, V]) GetOrSet(key K, v V) (actual V, loaded bool) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	now := time.Now()
+	if e, ok := m.items[key]; ok && !now.After(e.expires) {
+		return e.val, true
+	}
+	m.items[key] = ttlEntry[V]{val: v, expires: now.Add(m.ttl)}
+	return v, false
+}
+
+// Delete removes the value for key.
+func (m *TTLMap[K, V]) Delete(key K) {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	delete(m.items, key)
+}
+
+// Len returns the number of entries, including expired ones not yet evicted.
+func (m *TTLMap[K, V]) Len() int {
+	m.mx.Lock()
+	defer m.mx.Unlock()
+	return len(m.items)
+}
+
+// Close stops the background janitor.
+func (m *TTLMap[K, V]) Close() {
+	m.once.Do(func() { close(m.stop) })
+}
@@ -0,0 +1,58 @@
+package xgo
+
+// Pair is a tuple of two values.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple is a tuple of three values.
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// MakePair returns Pair of a, b.
+func MakePair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{a, b}
+}
+
+// MakeTriple returns Triple of a, b, c.
+func MakeTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
+
+// Unpack returns values of p.
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Swap returns Pair with values of p swapped.
+func (p Pair[A, B]) Swap() Pair[B, A] {
+	return Pair[B, A]{p.Second, p.First}
+}
+
+// Unpack returns values of t.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Zip returns pairs of elements of a and b; the result has the length of the shorter slice.
+func Zip[A, B any](a []A, b []B) []Pair[A, B] {
+	n := min(len(a), len(b))
+	res := make([]Pair[A, B], n)
+	for i := range n {
+		res[i] = Pair[A, B]{a[i], b[i]}
+	}
+	return res
+}
+
+// Unzip splits pairs into slices of first and second values.
+func Unzip[A, B any](pairs []Pair[A, B]) ([]A, []B) {
+	a, b := make([]A, len(pairs)), make([]B, len(pairs))
+	for i, p := range pairs {
+		a[i], b[i] = p.First, p.Second
+	}
+	return a, b
+}
@@ -0,0 +1,98 @@
+package xgo
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var byteUnits = map[string]float64{
+	"": 1, "b": 1,
+	"k": 1e3, "kb": 1e3, "kib": 1 << 10,
+	"m": 1e6, "mb": 1e6, "mib": 1 << 20,
+	"g": 1e9, "gb": 1e9, "gib": 1 << 30,
+	"t": 1e12, "tb": 1e12, "tib": 1 << 40,
+	"p": 1e15, "pb": 1e15, "pib": 1 << 50,
+	"e": 1e18, "eb": 1e18, "eib": 1 << 60,
+}
+
+var bytesRe = regexp.MustCompile(`^([0-9]*\.?[0-9]+)\s*([a-zA-Z]*)$`)
+
+// ParseBytes parses size like "10MiB", "1.5 GB" or "512". Decimal (KB) and binary (KiB) units are supported.
+func ParseBytes(s string) (int64, error) {
+	m := bytesRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, fmt.Errorf("xgo: invalid size %q", s)
+	}
+	unit, ok := byteUnits[strings.ToLower(m[2])]
+	if !ok {
+		return 0, fmt.Errorf("xgo: unknown size unit %q in %q", m[2], s)
+	}
+	f, err := strconv.ParseFloat(m[1], 64)
+	if err != nil || f*unit >= 1<<63 {
+		return 0, fmt.Errorf("xgo: invalid size %q", s)
+	}
+	return int64(f * unit), nil
+}
+
+// MustParseBytes returns parsed size or panics.
+func MustParseBytes(s string) int64 {
+	n, err := ParseBytes(s)
+	noErr(err)
+	return n
+}
+
+// FormatBytes returns n formatted with binary units, e.g. "1.5KiB".
+func FormatBytes(n int64) string {
+	const units = "KMGTPE"
+	if n < 1024 && n > -1024 {
+		return strconv.FormatInt(n, 10) + "B"
+	}
+	f, i := float64(n)/1024, 0
+	for ; Abs(math.Round(f*10)/10) >= 1024 && i < len(units)-1; i++ { // compare rounded value: 1023.99KiB is 1MiB
+		f /= 1024
+	}
+	s := strings.TrimSuffix(strconv.FormatFloat(f, 'f', 1, 64), ".0")
+	return s + units[i:i+1] + "iB"
+}
+
+var durationDaysRe = regexp.MustCompile(`^(?:([0-9]*\.?[0-9]+)w)?(?:([0-9]*\.?[0-9]+)d)?(.*)$`)
+
+// ParseDurationExt is like time.ParseDuration but also accepts leading weeks ("w") and days ("d"), e.g. "1w2d12h".
+func ParseDurationExt(s string) (time.Duration, error) {
+	str, neg := strings.CutPrefix(strings.TrimPrefix(s, "+"), "-")
+	m := durationDaysRe.FindStringSubmatch(str)
+	rest := m[3]
+	if str == "" || strings.HasPrefix(rest, "+") || strings.HasPrefix(rest, "-") {
+		return 0, fmt.Errorf("xgo: invalid duration %q", s)
+	}
+	var days float64
+	for i, unit := range []float64{7 * 24, 24} {
+		if m[i+1] != "" {
+			n, _ := strconv.ParseFloat(m[i+1], 64)
+			days += n * unit * float64(time.Hour)
+		}
+	}
+	if days >= math.MaxInt64 {
+		return 0, fmt.Errorf("xgo: invalid duration %q", s)
+	}
+	d := time.Duration(days)
+	if rest != "" || m[1]+m[2] == "" {
+		rd, err := time.ParseDuration(rest)
+		if err != nil || rd > math.MaxInt64-d {
+			return 0, fmt.Errorf("xgo: invalid duration %q", s)
+		}
+		d += rd
+	}
+	return If(neg, -d, d), nil
+}
+
+// MustParseDurationExt returns duration parsed by ParseDurationExt or panics.
+func MustParseDurationExt(s string) time.Duration {
+	d, err := ParseDurationExt(s)
+	noErr(err)
+	return d
+}
@@ -0,0 +1,54 @@
+package xgo
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// WG is a WaitGroup running functions safely and collecting their panics. A zero WG is ready to use.
+type WG struct {
+	wg   sync.WaitGroup
+	errs MultiError
+}
+
+// Go runs fn safely in a new goroutine tracked by the group.
+func (g *WG) Go(fn func()) {
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		g.errs.Add(Call(fn))
+	}()
+}
+
+// Wait waits for all goroutines to complete and returns joined errors of their panics.
+func (g *WG) Wait() error {
+	g.wg.Wait()
+	return g.errs.ErrorOrNil()
+}
+
+// WaitTimeout is like Wait but returns ErrTimeout if goroutines don't complete within d.
+func (g *WG) WaitTimeout(d time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), d)
+	defer cancel()
+	err := g.WaitCtx(ctx)
+	if err == context.DeadlineExceeded {
+		return ErrTimeout
+	}
+	return err
+}
+
+// WaitCtx is like Wait but returns ctx.Err() if ctx is done before goroutines complete.
+func (g *WG) WaitCtx(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		g.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return g.errs.ErrorOrNil()
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
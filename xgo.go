@@ -3,7 +3,7 @@ package xgo
 import (
 	"errors"
 	"fmt"
-	"runtime"
+	"reflect"
 	"sync"
 )
 
@@ -15,11 +15,65 @@ func If[T any](f bool, a, b T) T {
 	return b
 }
 
+// IfFunc returns a() when f is true, otherwise returns b(). Only the chosen branch is evaluated.
+func IfFunc[T any](f bool, a, b func() T) T {
+	if f {
+		return a()
+	}
+	return b()
+}
+
+// Cases is a lazily evaluated multi-way conditional built by Switch.
+type Cases[T any] struct {
+	matched bool
+	fn      func() T
+}
+
+// Switch starts a multi-way conditional: Switch[T]().Case(cond1, fn1).Case(cond2, fn2).Default(fn).
+func Switch[T any]() *Cases[T] {
+	return &Cases[T]{}
+}
+
+// Case adds a branch chosen when f is true and no previous branch was chosen.
+func (c *Cases[T]) Case(f bool, fn func() T) *Cases[T] {
+	if f && !c.matched {
+		c.matched, c.fn = true, fn
+	}
+	return c
+}
+
+// Default evaluates the chosen branch, or fn if no branch was chosen.
+func (c *Cases[T]) Default(fn func() T) T {
+	if c.matched {
+		return c.fn()
+	}
+	return fn()
+}
+
+// Ptr returns pointer to v.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns *p or zero value if p is nil.
+func Deref[T any](p *T) (v T) {
+	if p != nil {
+		return *p
+	}
+	return
+}
+
+// DerefOr returns *p or fallback if p is nil.
+func DerefOr[T any](p *T, fallback T) T {
+	if p != nil {
+		return *p
+	}
+	return fallback
+}
+
 func noErr(err error) {
 	if err != nil {
-		_, file, line, _ := runtime.Caller(2)
-		err = fmt.Errorf("%w\n\t%s:%d", err, file, line)
-		panic(err)
+		panicErr(err, 2)
 	}
 }
 
@@ -51,6 +105,61 @@ func Val3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
 	return v1, v2, v3
 }
 
+// OKMsg panics if err is not null, annotating the error with formatted message.
+func OKMsg(err error, format string, args ...any) {
+	if err != nil {
+		panicErr(fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err), 1)
+	}
+}
+
+// ValMsg returns v or panics if err is not null, annotating the error with formatted message.
+func ValMsg[T any](v T, err error, format string, args ...any) T {
+	if err != nil {
+		panicErr(fmt.Errorf("%s: %w", fmt.Sprintf(format, args...), err), 1)
+	}
+	return v
+}
+
+// OKSkip panics if err is not null, blaming the caller skip frames above the caller of OKSkip.
+// OKSkip(0, err) is equivalent to OK(err).
+func OKSkip(skip int, err error) {
+	if err != nil {
+		panicErr(err, skip+1)
+	}
+}
+
+// ValSkip returns v or panics if err is not null, blaming the caller skip frames above the caller of ValSkip.
+// ValSkip(0, v, err) is equivalent to Val(v, err).
+func ValSkip[T any](skip int, v T, err error) T {
+	if err != nil {
+		panicErr(err, skip+1)
+	}
+	return v
+}
+
+// As returns v asserted to type T and reports whether the assertion succeeded.
+func As[T any](v any) (T, bool) {
+	t, ok := v.(T)
+	return t, ok
+}
+
+// MustAs returns v asserted to type T or panics.
+func MustAs[T any](v any) T {
+	t, ok := v.(T)
+	if !ok {
+		noErr(fmt.Errorf("xgo: %T is not %v", v, reflect.TypeFor[T]()))
+	}
+	return t
+}
+
+// AsOr returns v asserted to type T or fallback if the assertion fails.
+func AsOr[T any](v any, fallback T) T {
+	if t, ok := v.(T); ok {
+		return t
+	}
+	return fallback
+}
+
 // SafeVal returns v and ignores error.
 func SafeVal[T any](v T, err error) T {
 	// ignore error
@@ -72,18 +181,38 @@ func SafeVal3[T1, T2, T3 any](v1 T1, v2 T2, v3 T3, err error) (T1, T2, T3) {
 // Require panics if statement is false.
 func Require(statement bool, err any) {
 	if !statement {
-		_, file, line, _ := runtime.Caller(1)
-		panic(fmt.Errorf("%w\n\t%s:%d", err, file, line))
+		panicErr(toError(err), 1)
+	}
+}
+
+// Requiref panics with formatted message if statement is false.
+func Requiref(statement bool, format string, args ...any) {
+	if !statement {
+		panicErr(fmt.Errorf(format, args...), 1)
+	}
+}
+
+// RequireNoZero returns v or panics if v is zero value, naming the checked value in the error.
+func RequireNoZero[T comparable](v T, name string) T {
+	if IsZero(v) {
+		panicErr(fmt.Errorf("xgo: %s must not be empty", name), 1)
 	}
+	return v
 }
 
 // Catch recovers and returns error by argument pointer.
+// The recovered panic is wrapped in *PanicError.
 func Catch(err *error) {
-	if r := recover(); r != nil && err != nil {
-		e, ok := r.(error)
-		if !ok {
-			e = fmt.Errorf("%v", r)
-		}
+	if r := recover(); r != nil {
+		catch(err, r)
+	}
+}
+
+func catch(err *error, r any) {
+	pe := newPanicError(r)
+	pe.report()
+	if err != nil {
+		var e error = pe
 		if *err != nil {
 			e = errors.Join(*err, e)
 		}
@@ -103,24 +232,47 @@ func Call(fn func()) (err error) {
 	return
 }
 
-// Go runs the function safely.
+// CallVal runs the function safely and returns its result, recovers panic-error.
+func CallVal[T any](fn func() T) (v T, err error) {
+	defer Catch(&err)
+	return fn(), nil
+}
+
+// CallVal2 runs the function safely and returns its results, recovers panic-error.
+func CallVal2[T1, T2 any](fn func() (T1, T2)) (v1 T1, v2 T2, err error) {
+	defer Catch(&err)
+	v1, v2 = fn()
+	return
+}
+
+func callErr(fn func() error) (err error) {
+	defer Catch(&err)
+	return fn()
+}
+
+// Go runs the function safely. A recovered panic is reported to the logger set by SetLogger.
 func Go(fn func()) {
-	go Call(fn)
+	go func() {
+		logPanic(Call(fn))
+	}()
 }
 
-// Async asynchronously runs several functions and waits for them to complete, returns an error in case of panic.
-func Async(fn ...func()) (err error) {
+// Async asynchronously runs several functions and waits for them to complete.
+// Returns joined errors of all panics.
+func Async(fn ...func()) error {
 	var wg sync.WaitGroup
+	errs := make([]error, len(fn))
 	wg.Add(len(fn))
-	for _, f := range fn {
-		go func(fn func()) {
+	for i, f := range fn {
+		go func(i int, fn func()) {
 			defer wg.Done()
-			defer Catch(&err)
-			fn()
-		}(f)
+			errs[i] = Call(fn)
+		}(i, f)
 	}
 	wg.Wait()
-	return
+	var me MultiError
+	me.Add(errs...) // keep input order
+	return me.ErrorOrNil()
 }
 
 // In reports whether v is present in ...value.
@@ -133,6 +285,60 @@ func In[T comparable](v T, value ...T) bool {
 	return false
 }
 
+// InFunc reports whether v is present in ...value according to eq.
+func InFunc[T any](v T, eq func(a, b T) bool, value ...T) bool {
+	for _, v2 := range value {
+		if eq(v, v2) {
+			return true
+		}
+	}
+	return false
+}
+
+// InDeep reports whether v is present in ...value according to reflect.DeepEqual.
+func InDeep[T any](v T, value ...T) bool {
+	return InFunc(v, func(a, b T) bool { return reflect.DeepEqual(a, b) }, value...)
+}
+
+// ExcludeDeep returns v if it is not present in vv according to reflect.DeepEqual.
+func ExcludeDeep[T any](v T, vv ...T) (result T) {
+	if InDeep(v, vv...) {
+		return
+	}
+	return v
+}
+
+// CheckIn returns an error listing allowed values if v is not present in allowed.
+func CheckIn[T comparable](v T, allowed ...T) error {
+	if In(v, allowed...) {
+		return nil
+	}
+	return fmt.Errorf("xgo: value %v is not one of %v", v, allowed)
+}
+
+// MustBeIn returns v or panics if v is not present in allowed.
+func MustBeIn[T comparable](v T, allowed ...T) T {
+	noErr(CheckIn(v, allowed...))
+	return v
+}
+
+// Zero returns zero value of T.
+func Zero[T any]() (v T) {
+	return
+}
+
+// IsZero reports whether v is zero value of T.
+func IsZero[T comparable](v T) bool {
+	var v0 T
+	return v == v0
+}
+
+// IsZeroDeep reports whether v is zero value of T using reflection; works for non-comparable types.
+func IsZeroDeep[T any](v T) bool {
+	rv := reflect.ValueOf(&v).Elem()
+	return rv.IsZero()
+}
+
 // Or returns the first non-empty value.
 func Or[T comparable](values ...T) (v0 T) {
 	for _, v := range values {
@@ -143,6 +349,61 @@ func Or[T comparable](values ...T) (v0 T) {
 	return
 }
 
+// OrFunc returns the first value for which isZero(v) is false.
+func OrFunc[T any](isZero func(T) bool, values ...T) (v0 T) {
+	for _, v := range values {
+		if !isZero(v) {
+			return v
+		}
+	}
+	return
+}
+
+// OrZero returns the first value that is not zero value of T; works for non-comparable types
+// (nil slices, maps and funcs are zero, empty non-nil ones are not).
+func OrZero[T any](values ...T) T {
+	return OrFunc(IsZeroDeep[T], values...)
+}
+
+// OrAny returns the first value that is neither nil nor zero value of its dynamic type.
+func OrAny(values ...any) any {
+	for _, v := range values {
+		if v != nil && !reflect.ValueOf(v).IsZero() {
+			return v
+		}
+	}
+	return nil
+}
+
+// OrElse returns v if it is non-empty, otherwise returns fallback().
+func OrElse[T comparable](v T, fallback func() T) T {
+	var v0 T
+	if v != v0 {
+		return v
+	}
+	return fallback()
+}
+
+// CoalesceErr returns the first non-nil error.
+func CoalesceErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FirstNonNil returns the first non-nil pointer.
+func FirstNonNil[T any](ptrs ...*T) *T {
+	for _, p := range ptrs {
+		if p != nil {
+			return p
+		}
+	}
+	return nil
+}
+
 // FilterFunc returns v if v satisfies filter(v).
 func FilterFunc[T any](v T, filter func(T) bool) (_ T) {
 	if filter(v) {
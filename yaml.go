@@ -0,0 +1,243 @@
+package xgo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// yamlLine is a non-empty line of YAML document without comment.
+type yamlLine struct {
+	num    int // 1-based line number
+	indent int
+	text   string
+}
+
+// parseYAML parses a subset of YAML: block mappings and sequences, flow sequences of scalars,
+// plain and quoted scalars. Anchors, aliases, tags, block scalars and multiple documents are not supported.
+func parseYAML(data []byte) (map[string]any, error) {
+	var lines []yamlLine
+	for i, s := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		s = strings.TrimRight(yamlStripComment(s), " \t")
+		if t := strings.TrimLeft(s, " "); t != "" && t != "---" {
+			if strings.HasPrefix(t, "\t") {
+				return nil, fmt.Errorf("line %d: tabs are not allowed in indentation", i+1)
+			}
+			lines = append(lines, yamlLine{i + 1, len(s) - len(t), t})
+		}
+	}
+	if len(lines) == 0 {
+		return map[string]any{}, nil
+	}
+	p := &yamlParser{lines: lines}
+	v, err := p.block(lines[0].indent)
+	if err == nil && p.i < len(lines) {
+		p.num = lines[p.i].num
+		err = p.errorf("unexpected indentation")
+	}
+	if err != nil {
+		return nil, err
+	}
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("top-level value is not a mapping")
+	}
+	return m, nil
+}
+
+type yamlParser struct {
+	lines []yamlLine
+	i     int
+	num   int // number of the line being parsed
+}
+
+func (p *yamlParser) errorf(format string, args ...any) error {
+	return fmt.Errorf("line %d: %s", p.num, fmt.Sprintf(format, args...))
+}
+
+func (p *yamlParser) block(indent int) (any, error) {
+	if isYAMLSeqItem(p.lines[p.i].text) {
+		return p.sequence(indent)
+	}
+	return p.mapping(indent)
+}
+
+func (p *yamlParser) mapping(indent int) (any, error) {
+	m := map[string]any{}
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent && !isYAMLSeqItem(p.lines[p.i].text) {
+		p.num = p.lines[p.i].num
+		key, val, ok := yamlCutKey(p.lines[p.i].text)
+		if !ok {
+			return nil, p.errorf("expected key: value")
+		}
+		if _, dup := m[key]; dup {
+			return nil, p.errorf("duplicate key %q", key)
+		}
+		p.i++
+		v, err := p.value(val, indent, true)
+		if err != nil {
+			return nil, err
+		}
+		m[key] = v
+	}
+	return m, nil
+}
+
+func (p *yamlParser) sequence(indent int) (any, error) {
+	s := []any{}
+	for p.i < len(p.lines) && p.lines[p.i].indent == indent && isYAMLSeqItem(p.lines[p.i].text) {
+		l := &p.lines[p.i]
+		p.num = l.num
+		item := strings.TrimLeft(strings.TrimPrefix(l.text, "-"), " ")
+		if _, _, ok := yamlCutKey(item); ok && !strings.HasPrefix(item, "[") {
+			// "- key: value" starts a mapping indented at the column of key
+			l.indent += len(l.text) - len(item)
+			l.text = item
+			v, err := p.mapping(l.indent)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, v)
+			continue
+		}
+		p.i++
+		v, err := p.value(item, indent, false)
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, v)
+	}
+	return s, nil
+}
+
+// value parses the value following a key or a sequence dash at indent.
+// An empty value is a nested block on the next lines; a mapping value may also be a sequence at the same indent.
+func (p *yamlParser) value(val string, indent int, inMapping bool) (any, error) {
+	if val != "" {
+		return p.scalar(val)
+	}
+	if p.i < len(p.lines) {
+		next := p.lines[p.i]
+		if next.indent > indent || inMapping && next.indent == indent && isYAMLSeqItem(next.text) {
+			return p.block(next.indent)
+		}
+	}
+	return nil, nil
+}
+
+func (p *yamlParser) scalar(s string) (any, error) {
+	switch {
+	case yamlQuoted(s):
+		v, err := yamlUnquote(s)
+		if err != nil {
+			return nil, p.errorf("invalid quoted string %s", s)
+		}
+		return v, nil
+	case strings.HasPrefix(s, "["):
+		if !strings.HasSuffix(s, "]") {
+			return nil, p.errorf("invalid flow sequence %s", s)
+		}
+		res := []any{}
+		if inner := strings.TrimSpace(s[1 : len(s)-1]); inner != "" {
+			for _, item := range strings.Split(inner, ",") {
+				v, err := p.scalar(strings.TrimSpace(item))
+				if err != nil {
+					return nil, err
+				}
+				res = append(res, v)
+			}
+		}
+		return res, nil
+	case s == "{}":
+		return map[string]any{}, nil
+	case strings.ContainsAny(s[:1], "{&*!|>%@`"):
+		return nil, p.errorf("unsupported YAML syntax %s", s)
+	}
+	switch s {
+	case "null", "Null", "NULL", "~":
+		return nil, nil
+	case "true", "True", "TRUE":
+		return true, nil
+	case "false", "False", "FALSE":
+		return false, nil
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !strings.ContainsAny(s, "xXpP_") {
+		return f, nil
+	}
+	return s, nil
+}
+
+func isYAMLSeqItem(s string) bool {
+	return s == "-" || strings.HasPrefix(s, "- ")
+}
+
+func yamlQuoted(s string) bool {
+	return strings.HasPrefix(s, `"`) || strings.HasPrefix(s, "'")
+}
+
+// yamlCutKey splits "key: value" or "key:"; the key may be quoted.
+func yamlCutKey(s string) (key, val string, ok bool) {
+	end := -1
+	if yamlQuoted(s) {
+		if end = strings.Index(s[1:], s[:1]); end < 0 {
+			return "", "", false
+		}
+		end += 2
+		if end < len(s) && s[end] != ':' {
+			return "", "", false
+		}
+	} else {
+		for i := 0; i < len(s); i++ {
+			if s[i] == ':' && (i == len(s)-1 || s[i+1] == ' ') {
+				end = i
+				break
+			}
+		}
+	}
+	if end <= 0 || end >= len(s) || s[end] != ':' {
+		return "", "", false
+	}
+	key = strings.TrimSpace(s[:end])
+	if yamlQuoted(key) {
+		var err error
+		if key, err = yamlUnquote(key); err != nil {
+			return "", "", false
+		}
+	}
+	return key, strings.TrimSpace(s[end+1:]), true
+}
+
+func yamlUnquote(s string) (string, error) {
+	if s[0] == '"' {
+		return strconv.Unquote(s)
+	}
+	if len(s) < 2 || !strings.HasSuffix(s, "'") {
+		return "", strconv.ErrSyntax
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], "''", "'"), nil
+}
+
+// yamlStripComment removes a "#" comment outside quotes.
+func yamlStripComment(s string) string {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case quote != 0:
+			if c == '\\' && quote == '"' {
+				i++
+			} else if c == quote {
+				quote = 0
+			}
+		case c == '"' || c == '\'':
+			if i == 0 || strings.ContainsRune(" [,:-", rune(s[i-1])) {
+				quote = c
+			}
+		case c == '#' && (i == 0 || s[i-1] == ' ' || s[i-1] == '\t'):
+			return s[:i]
+		}
+	}
+	return s
+}